@@ -359,6 +359,9 @@ type PhysicalIO struct {
 	//    a string to address this.
 	// "ioports": the address is a string such as "2f8-2ff"
 	// "usbaddr": the address is a USB of the form of "1:2.3"
+	// "usbproduct": instead of an address a USB device can be matched by
+	//    its vendor and product IDs such as "0bda:8153"
+	// "usbserial": the serial number to match in addition to usbproduct
 	// If the type is PhyIoNet*, then there needs to be an "ifname" physaddr.
 	Phyaddrs map[string]string `protobuf:"bytes,3,rep,name=phyaddrs,proto3" json:"phyaddrs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// logicallabel - provides the ability to model designer to refer
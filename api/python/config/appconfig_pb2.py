@@ -2,6 +2,7 @@
 # Generated by the protocol buffer compiler.  DO NOT EDIT!
 # source: config/appconfig.proto
 
+from google.protobuf.internal import enum_type_wrapper
 from google.protobuf import descriptor as _descriptor
 from google.protobuf import message as _message
 from google.protobuf import reflection as _reflection
@@ -24,10 +25,192 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   syntax='proto3',
   serialized_options=b'\n\025org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/config',
   create_key=_descriptor._internal_create_key,
-  serialized_pb=b'\n\x16\x63onfig/appconfig.proto\x12\x15org.lfedge.eve.config\x1a\x18\x63onfig/acipherinfo.proto\x1a\x16\x63onfig/devcommon.proto\x1a\x14\x63onfig/storage.proto\x1a\x0f\x63onfig/vm.proto\x1a\x16\x63onfig/netconfig.proto\"2\n\x0eInstanceOpsCmd\x12\x0f\n\x07\x63ounter\x18\x02 \x01(\r\x12\x0f\n\x07opsTime\x18\x04 \x01(\t\"\xf1\x04\n\x11\x41ppInstanceConfig\x12=\n\x0euuidandversion\x18\x01 \x01(\x0b\x32%.org.lfedge.eve.config.UUIDandVersion\x12\x13\n\x0b\x64isplayname\x18\x02 \x01(\t\x12\x37\n\x0e\x66ixedresources\x18\x03 \x01(\x0b\x32\x1f.org.lfedge.eve.config.VmConfig\x12,\n\x06\x64rives\x18\x04 \x03(\x0b\x32\x1c.org.lfedge.eve.config.Drive\x12\x10\n\x08\x61\x63tivate\x18\x05 \x01(\x08\x12\x39\n\ninterfaces\x18\x06 \x03(\x0b\x32%.org.lfedge.eve.config.NetworkAdapter\x12\x30\n\x08\x61\x64\x61pters\x18\x07 \x03(\x0b\x32\x1e.org.lfedge.eve.config.Adapter\x12\x36\n\x07restart\x18\t \x01(\x0b\x32%.org.lfedge.eve.config.InstanceOpsCmd\x12\x34\n\x05purge\x18\n \x01(\x0b\x32%.org.lfedge.eve.config.InstanceOpsCmd\x12\x10\n\x08userData\x18\x0b \x01(\t\x12\x15\n\rremoteConsole\x18\x0c \x01(\x08\x12\x36\n\ncipherData\x18\r \x01(\x0b\x32\".org.lfedge.eve.config.CipherBlock\x12\x1a\n\x12\x63ollectStatsIPAddr\x18\x0f \x01(\t\x12\x37\n\rvolumeRefList\x18\x10 \x03(\x0b\x32 .org.lfedge.eve.config.VolumeRef\"E\n\tVolumeRef\x12\x0c\n\x04uuid\x18\x01 \x01(\t\x12\x17\n\x0fgenerationCount\x18\x02 \x01(\x03\x12\x11\n\tmount_dir\x18\x03 \x01(\tB=\n\x15org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/configb\x06proto3'
+  serialized_pb=b'\n\x16\x63onfig/appconfig.proto\x12\x15org.lfedge.eve.config\x1a\x18\x63onfig/acipherinfo.proto\x1a\x16\x63onfig/devcommon.proto\x1a\x14\x63onfig/storage.proto\x1a\x0f\x63onfig/vm.proto\x1a\x16\x63onfig/netconfig.proto\"2\n\x0eInstanceOpsCmd\x12\x0f\n\x07\x63ounter\x18\x02 \x01(\r\x12\x0f\n\x07opsTime\x18\x04 \x01(\t\"c\n\x0bSnapshotCmd\x12\x0f\n\x07\x63ounter\x18\x01 \x01(\r\x12\x35\n\x06\x61\x63tion\x18\x02 \x01(\x0e\x32%.org.lfedge.eve.config.SnapshotAction\x12\x0c\n\x04name\x18\x03 \x01(\t\"\xcf\x01\n\x0bHealthProbe\x12\x34\n\x04type\x18\x01 \x01(\x0e\x32&.org.lfedge.eve.config.HealthProbeType\x12\x0c\n\x04port\x18\x02 \x01(\r\x12\x0c\n\x04path\x18\x03 \x01(\t\x12\x0f\n\x07\x63ommand\x18\x04 \x03(\t\x12\x18\n\x10initialDelaySecs\x18\x05 \x01(\r\x12\x14\n\x0cintervalSecs\x18\x06 \x01(\r\x12\x13\n\x0btimeoutSecs\x18\x07 \x01(\r\x12\x18\n\x10\x66\x61ilureThreshold\x18\x08 \x01(\r\"X\n\rAppDependency\x12\x0f\n\x07\x61ppUuid\x18\x01 \x01(\t\x12\x36\n\x04type\x18\x02 \x01(\x0e\x32(.org.lfedge.eve.config.AppDependencyType\"0\n\x0cPodContainer\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x12\n\nvolumeUuid\x18\x02 \x01(\t\"\x9d\x01\n\x0fSecurityProfile\x12\x0f\n\x07seccomp\x18\x01 \x01(\t\x12\x10\n\x08\x61ppArmor\x18\x02 \x01(\t\x12\x0e\n\x06\x63\x61pAdd\x18\x03 \x03(\t\x12\x0f\n\x07\x63\x61pDrop\x18\x04 \x03(\t\x12\x16\n\x0ereadOnlyRootfs\x18\x05 \x01(\x08\x12\x17\n\x0fnoNewPrivileges\x18\x06 \x01(\x08\x12\x15\n\ruserNamespace\x18\x07 \x01(\x08\"\x9f\x08\n\x11\x41ppInstanceConfig\x12=\n\x0euuidandversion\x18\x01 \x01(\x0b\x32%.org.lfedge.eve.config.UUIDandVersion\x12\x13\n\x0b\x64isplayname\x18\x02 \x01(\t\x12\x37\n\x0e\x66ixedresources\x18\x03 \x01(\x0b\x32\x1f.org.lfedge.eve.config.VmConfig\x12,\n\x06\x64rives\x18\x04 \x03(\x0b\x32\x1c.org.lfedge.eve.config.Drive\x12\x10\n\x08\x61\x63tivate\x18\x05 \x01(\x08\x12\x39\n\ninterfaces\x18\x06 \x03(\x0b\x32%.org.lfedge.eve.config.NetworkAdapter\x12\x30\n\x08\x61\x64\x61pters\x18\x07 \x03(\x0b\x32\x1e.org.lfedge.eve.config.Adapter\x12\x36\n\x07restart\x18\t \x01(\x0b\x32%.org.lfedge.eve.config.InstanceOpsCmd\x12\x34\n\x05purge\x18\n \x01(\x0b\x32%.org.lfedge.eve.config.InstanceOpsCmd\x12\x10\n\x08userData\x18\x0b \x01(\t\x12\x15\n\rremoteConsole\x18\x0c \x01(\x08\x12\x36\n\ncipherData\x18\r \x01(\x0b\x32\".org.lfedge.eve.config.CipherBlock\x12\x1a\n\x12\x63ollectStatsIPAddr\x18\x0f \x01(\t\x12\x37\n\rvolumeRefList\x18\x10 \x03(\x0b\x32 .org.lfedge.eve.config.VolumeRef\x12\x34\n\x08snapshot\x18\x11 \x01(\x0b\x32\".org.lfedge.eve.config.SnapshotCmd\x12>\n\rrestartPolicy\x18\x12 \x01(\x0e\x32\'.org.lfedge.eve.config.AppRestartPolicy\x12\x38\n\x0chealthProbes\x18\x13 \x03(\x0b\x32\".org.lfedge.eve.config.HealthProbe\x12\x43\n\x11healthRemediation\x18\x14 \x01(\x0e\x32(.org.lfedge.eve.config.HealthRemediation\x12:\n\x0c\x64\x65pendencies\x18\x15 \x03(\x0b\x32$.org.lfedge.eve.config.AppDependency\x12?\n\x0fsecurityProfile\x18\x16 \x01(\x0b\x32&.org.lfedge.eve.config.SecurityProfile\x12:\n\rpodContainers\x18\x17 \x03(\x0b\x32#.org.lfedge.eve.config.PodContainer\"E\n\tVolumeRef\x12\x0c\n\x04uuid\x18\x01 \x01(\t\x12\x17\n\x0fgenerationCount\x18\x02 \x01(\x03\x12\x11\n\tmount_dir\x18\x03 \x01(\t*h\n\x0eSnapshotAction\x12\x1f\n\x1bSNAPSHOT_ACTION_UNSPECIFIED\x10\x00\x12\x18\n\x14SNAPSHOT_ACTION_SAVE\x10\x01\x12\x1b\n\x17SNAPSHOT_ACTION_RESTORE\x10\x02*\x96\x01\n\x10\x41ppRestartPolicy\x12\"\n\x1e\x41PP_RESTART_POLICY_UNSPECIFIED\x10\x00\x12\x1c\n\x18\x41PP_RESTART_POLICY_NEVER\x10\x01\x12!\n\x1d\x41PP_RESTART_POLICY_ON_FAILURE\x10\x02\x12\x1d\n\x19\x41PP_RESTART_POLICY_ALWAYS\x10\x03*\x87\x01\n\x0fHealthProbeType\x12!\n\x1dHEALTH_PROBE_TYPE_UNSPECIFIED\x10\x00\x12\x1a\n\x16HEALTH_PROBE_TYPE_HTTP\x10\x01\x12\x19\n\x15HEALTH_PROBE_TYPE_TCP\x10\x02\x12\x1a\n\x16HEALTH_PROBE_TYPE_EXEC\x10\x03*W\n\x11HealthRemediation\x12\"\n\x1eHEALTH_REMEDIATION_UNSPECIFIED\x10\x00\x12\x1e\n\x1aHEALTH_REMEDIATION_RESTART\x10\x01*\xa8\x01\n\x11\x41ppDependencyType\x12#\n\x1f\x41PP_DEPENDENCY_TYPE_UNSPECIFIED\x10\x00\x12#\n\x1f\x41PP_DEPENDENCY_TYPE_START_AFTER\x10\x01\x12$\n APP_DEPENDENCY_TYPE_WAIT_HEALTHY\x10\x02\x12#\n\x1f\x41PP_DEPENDENCY_TYPE_STOP_BEFORE\x10\x03\x42=\n\x15org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/configb\x06proto3'
   ,
   dependencies=[config_dot_acipherinfo__pb2.DESCRIPTOR,config_dot_devcommon__pb2.DESCRIPTOR,config_dot_storage__pb2.DESCRIPTOR,config_dot_vm__pb2.DESCRIPTOR,config_dot_netconfig__pb2.DESCRIPTOR,])
 
+_SNAPSHOTACTION = _descriptor.EnumDescriptor(
+  name='SnapshotAction',
+  full_name='org.lfedge.eve.config.SnapshotAction',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='SNAPSHOT_ACTION_UNSPECIFIED', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='SNAPSHOT_ACTION_SAVE', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='SNAPSHOT_ACTION_RESTORE', index=2, number=2,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=1954,
+  serialized_end=2058,
+)
+_sym_db.RegisterEnumDescriptor(_SNAPSHOTACTION)
+
+SnapshotAction = enum_type_wrapper.EnumTypeWrapper(_SNAPSHOTACTION)
+_APPRESTARTPOLICY = _descriptor.EnumDescriptor(
+  name='AppRestartPolicy',
+  full_name='org.lfedge.eve.config.AppRestartPolicy',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='APP_RESTART_POLICY_UNSPECIFIED', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='APP_RESTART_POLICY_NEVER', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='APP_RESTART_POLICY_ON_FAILURE', index=2, number=2,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='APP_RESTART_POLICY_ALWAYS', index=3, number=3,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=2061,
+  serialized_end=2211,
+)
+_sym_db.RegisterEnumDescriptor(_APPRESTARTPOLICY)
+
+AppRestartPolicy = enum_type_wrapper.EnumTypeWrapper(_APPRESTARTPOLICY)
+_HEALTHPROBETYPE = _descriptor.EnumDescriptor(
+  name='HealthProbeType',
+  full_name='org.lfedge.eve.config.HealthProbeType',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='HEALTH_PROBE_TYPE_UNSPECIFIED', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='HEALTH_PROBE_TYPE_HTTP', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='HEALTH_PROBE_TYPE_TCP', index=2, number=2,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='HEALTH_PROBE_TYPE_EXEC', index=3, number=3,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=2214,
+  serialized_end=2349,
+)
+_sym_db.RegisterEnumDescriptor(_HEALTHPROBETYPE)
+
+HealthProbeType = enum_type_wrapper.EnumTypeWrapper(_HEALTHPROBETYPE)
+_HEALTHREMEDIATION = _descriptor.EnumDescriptor(
+  name='HealthRemediation',
+  full_name='org.lfedge.eve.config.HealthRemediation',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='HEALTH_REMEDIATION_UNSPECIFIED', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='HEALTH_REMEDIATION_RESTART', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=2351,
+  serialized_end=2438,
+)
+_sym_db.RegisterEnumDescriptor(_HEALTHREMEDIATION)
+
+HealthRemediation = enum_type_wrapper.EnumTypeWrapper(_HEALTHREMEDIATION)
+_APPDEPENDENCYTYPE = _descriptor.EnumDescriptor(
+  name='AppDependencyType',
+  full_name='org.lfedge.eve.config.AppDependencyType',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='APP_DEPENDENCY_TYPE_UNSPECIFIED', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='APP_DEPENDENCY_TYPE_START_AFTER', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='APP_DEPENDENCY_TYPE_WAIT_HEALTHY', index=2, number=2,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='APP_DEPENDENCY_TYPE_STOP_BEFORE', index=3, number=3,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=2441,
+  serialized_end=2609,
+)
+_sym_db.RegisterEnumDescriptor(_APPDEPENDENCYTYPE)
+
+AppDependencyType = enum_type_wrapper.EnumTypeWrapper(_APPDEPENDENCYTYPE)
+SNAPSHOT_ACTION_UNSPECIFIED = 0
+SNAPSHOT_ACTION_SAVE = 1
+SNAPSHOT_ACTION_RESTORE = 2
+APP_RESTART_POLICY_UNSPECIFIED = 0
+APP_RESTART_POLICY_NEVER = 1
+APP_RESTART_POLICY_ON_FAILURE = 2
+APP_RESTART_POLICY_ALWAYS = 3
+HEALTH_PROBE_TYPE_UNSPECIFIED = 0
+HEALTH_PROBE_TYPE_HTTP = 1
+HEALTH_PROBE_TYPE_TCP = 2
+HEALTH_PROBE_TYPE_EXEC = 3
+HEALTH_REMEDIATION_UNSPECIFIED = 0
+HEALTH_REMEDIATION_RESTART = 1
+APP_DEPENDENCY_TYPE_UNSPECIFIED = 0
+APP_DEPENDENCY_TYPE_START_AFTER = 1
+APP_DEPENDENCY_TYPE_WAIT_HEALTHY = 2
+APP_DEPENDENCY_TYPE_STOP_BEFORE = 3
 
 
 
@@ -70,6 +253,285 @@ _INSTANCEOPSCMD = _descriptor.Descriptor(
 )
 
 
+_SNAPSHOTCMD = _descriptor.Descriptor(
+  name='SnapshotCmd',
+  full_name='org.lfedge.eve.config.SnapshotCmd',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='counter', full_name='org.lfedge.eve.config.SnapshotCmd.counter', index=0,
+      number=1, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='action', full_name='org.lfedge.eve.config.SnapshotCmd.action', index=1,
+      number=2, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='name', full_name='org.lfedge.eve.config.SnapshotCmd.name', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=214,
+  serialized_end=313,
+)
+
+
+_HEALTHPROBE = _descriptor.Descriptor(
+  name='HealthProbe',
+  full_name='org.lfedge.eve.config.HealthProbe',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='type', full_name='org.lfedge.eve.config.HealthProbe.type', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='port', full_name='org.lfedge.eve.config.HealthProbe.port', index=1,
+      number=2, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='path', full_name='org.lfedge.eve.config.HealthProbe.path', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='command', full_name='org.lfedge.eve.config.HealthProbe.command', index=3,
+      number=4, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='initialDelaySecs', full_name='org.lfedge.eve.config.HealthProbe.initialDelaySecs', index=4,
+      number=5, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='intervalSecs', full_name='org.lfedge.eve.config.HealthProbe.intervalSecs', index=5,
+      number=6, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='timeoutSecs', full_name='org.lfedge.eve.config.HealthProbe.timeoutSecs', index=6,
+      number=7, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='failureThreshold', full_name='org.lfedge.eve.config.HealthProbe.failureThreshold', index=7,
+      number=8, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=316,
+  serialized_end=523,
+)
+
+
+_APPDEPENDENCY = _descriptor.Descriptor(
+  name='AppDependency',
+  full_name='org.lfedge.eve.config.AppDependency',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='appUuid', full_name='org.lfedge.eve.config.AppDependency.appUuid', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='type', full_name='org.lfedge.eve.config.AppDependency.type', index=1,
+      number=2, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=525,
+  serialized_end=613,
+)
+
+
+_PODCONTAINER = _descriptor.Descriptor(
+  name='PodContainer',
+  full_name='org.lfedge.eve.config.PodContainer',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='name', full_name='org.lfedge.eve.config.PodContainer.name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='volumeUuid', full_name='org.lfedge.eve.config.PodContainer.volumeUuid', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=615,
+  serialized_end=663,
+)
+
+
+_SECURITYPROFILE = _descriptor.Descriptor(
+  name='SecurityProfile',
+  full_name='org.lfedge.eve.config.SecurityProfile',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='seccomp', full_name='org.lfedge.eve.config.SecurityProfile.seccomp', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='appArmor', full_name='org.lfedge.eve.config.SecurityProfile.appArmor', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='capAdd', full_name='org.lfedge.eve.config.SecurityProfile.capAdd', index=2,
+      number=3, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='capDrop', full_name='org.lfedge.eve.config.SecurityProfile.capDrop', index=3,
+      number=4, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='readOnlyRootfs', full_name='org.lfedge.eve.config.SecurityProfile.readOnlyRootfs', index=4,
+      number=5, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='noNewPrivileges', full_name='org.lfedge.eve.config.SecurityProfile.noNewPrivileges', index=5,
+      number=6, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='userNamespace', full_name='org.lfedge.eve.config.SecurityProfile.userNamespace', index=6,
+      number=7, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=666,
+  serialized_end=823,
+)
+
+
 _APPINSTANCECONFIG = _descriptor.Descriptor(
   name='AppInstanceConfig',
   full_name='org.lfedge.eve.config.AppInstanceConfig',
@@ -176,6 +638,55 @@ _APPINSTANCECONFIG = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='snapshot', full_name='org.lfedge.eve.config.AppInstanceConfig.snapshot', index=14,
+      number=17, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='restartPolicy', full_name='org.lfedge.eve.config.AppInstanceConfig.restartPolicy', index=15,
+      number=18, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='healthProbes', full_name='org.lfedge.eve.config.AppInstanceConfig.healthProbes', index=16,
+      number=19, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='healthRemediation', full_name='org.lfedge.eve.config.AppInstanceConfig.healthRemediation', index=17,
+      number=20, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='dependencies', full_name='org.lfedge.eve.config.AppInstanceConfig.dependencies', index=18,
+      number=21, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='securityProfile', full_name='org.lfedge.eve.config.AppInstanceConfig.securityProfile', index=19,
+      number=22, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='podContainers', full_name='org.lfedge.eve.config.AppInstanceConfig.podContainers', index=20,
+      number=23, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -188,8 +699,8 @@ _APPINSTANCECONFIG = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=215,
-  serialized_end=840,
+  serialized_start=826,
+  serialized_end=1881,
 )
 
 
@@ -234,10 +745,13 @@ _VOLUMEREF = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=842,
-  serialized_end=911,
+  serialized_start=1883,
+  serialized_end=1952,
 )
 
+_SNAPSHOTCMD.fields_by_name['action'].enum_type = _SNAPSHOTACTION
+_HEALTHPROBE.fields_by_name['type'].enum_type = _HEALTHPROBETYPE
+_APPDEPENDENCY.fields_by_name['type'].enum_type = _APPDEPENDENCYTYPE
 _APPINSTANCECONFIG.fields_by_name['uuidandversion'].message_type = config_dot_devcommon__pb2._UUIDANDVERSION
 _APPINSTANCECONFIG.fields_by_name['fixedresources'].message_type = config_dot_vm__pb2._VMCONFIG
 _APPINSTANCECONFIG.fields_by_name['drives'].message_type = config_dot_storage__pb2._DRIVE
@@ -247,9 +761,26 @@ _APPINSTANCECONFIG.fields_by_name['restart'].message_type = _INSTANCEOPSCMD
 _APPINSTANCECONFIG.fields_by_name['purge'].message_type = _INSTANCEOPSCMD
 _APPINSTANCECONFIG.fields_by_name['cipherData'].message_type = config_dot_acipherinfo__pb2._CIPHERBLOCK
 _APPINSTANCECONFIG.fields_by_name['volumeRefList'].message_type = _VOLUMEREF
+_APPINSTANCECONFIG.fields_by_name['snapshot'].message_type = _SNAPSHOTCMD
+_APPINSTANCECONFIG.fields_by_name['restartPolicy'].enum_type = _APPRESTARTPOLICY
+_APPINSTANCECONFIG.fields_by_name['healthProbes'].message_type = _HEALTHPROBE
+_APPINSTANCECONFIG.fields_by_name['healthRemediation'].enum_type = _HEALTHREMEDIATION
+_APPINSTANCECONFIG.fields_by_name['dependencies'].message_type = _APPDEPENDENCY
+_APPINSTANCECONFIG.fields_by_name['securityProfile'].message_type = _SECURITYPROFILE
+_APPINSTANCECONFIG.fields_by_name['podContainers'].message_type = _PODCONTAINER
 DESCRIPTOR.message_types_by_name['InstanceOpsCmd'] = _INSTANCEOPSCMD
+DESCRIPTOR.message_types_by_name['SnapshotCmd'] = _SNAPSHOTCMD
+DESCRIPTOR.message_types_by_name['HealthProbe'] = _HEALTHPROBE
+DESCRIPTOR.message_types_by_name['AppDependency'] = _APPDEPENDENCY
+DESCRIPTOR.message_types_by_name['PodContainer'] = _PODCONTAINER
+DESCRIPTOR.message_types_by_name['SecurityProfile'] = _SECURITYPROFILE
 DESCRIPTOR.message_types_by_name['AppInstanceConfig'] = _APPINSTANCECONFIG
 DESCRIPTOR.message_types_by_name['VolumeRef'] = _VOLUMEREF
+DESCRIPTOR.enum_types_by_name['SnapshotAction'] = _SNAPSHOTACTION
+DESCRIPTOR.enum_types_by_name['AppRestartPolicy'] = _APPRESTARTPOLICY
+DESCRIPTOR.enum_types_by_name['HealthProbeType'] = _HEALTHPROBETYPE
+DESCRIPTOR.enum_types_by_name['HealthRemediation'] = _HEALTHREMEDIATION
+DESCRIPTOR.enum_types_by_name['AppDependencyType'] = _APPDEPENDENCYTYPE
 _sym_db.RegisterFileDescriptor(DESCRIPTOR)
 
 InstanceOpsCmd = _reflection.GeneratedProtocolMessageType('InstanceOpsCmd', (_message.Message,), {
@@ -259,6 +790,41 @@ InstanceOpsCmd = _reflection.GeneratedProtocolMessageType('InstanceOpsCmd', (_me
   })
 _sym_db.RegisterMessage(InstanceOpsCmd)
 
+SnapshotCmd = _reflection.GeneratedProtocolMessageType('SnapshotCmd', (_message.Message,), {
+  'DESCRIPTOR' : _SNAPSHOTCMD,
+  '__module__' : 'config.appconfig_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.SnapshotCmd)
+  })
+_sym_db.RegisterMessage(SnapshotCmd)
+
+HealthProbe = _reflection.GeneratedProtocolMessageType('HealthProbe', (_message.Message,), {
+  'DESCRIPTOR' : _HEALTHPROBE,
+  '__module__' : 'config.appconfig_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.HealthProbe)
+  })
+_sym_db.RegisterMessage(HealthProbe)
+
+AppDependency = _reflection.GeneratedProtocolMessageType('AppDependency', (_message.Message,), {
+  'DESCRIPTOR' : _APPDEPENDENCY,
+  '__module__' : 'config.appconfig_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.AppDependency)
+  })
+_sym_db.RegisterMessage(AppDependency)
+
+PodContainer = _reflection.GeneratedProtocolMessageType('PodContainer', (_message.Message,), {
+  'DESCRIPTOR' : _PODCONTAINER,
+  '__module__' : 'config.appconfig_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.PodContainer)
+  })
+_sym_db.RegisterMessage(PodContainer)
+
+SecurityProfile = _reflection.GeneratedProtocolMessageType('SecurityProfile', (_message.Message,), {
+  'DESCRIPTOR' : _SECURITYPROFILE,
+  '__module__' : 'config.appconfig_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.SecurityProfile)
+  })
+_sym_db.RegisterMessage(SecurityProfile)
+
 AppInstanceConfig = _reflection.GeneratedProtocolMessageType('AppInstanceConfig', (_message.Message,), {
   'DESCRIPTOR' : _APPINSTANCECONFIG,
   '__module__' : 'config.appconfig_pb2'
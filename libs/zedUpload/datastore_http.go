@@ -4,6 +4,7 @@
 package zedUpload
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"net/http"
@@ -23,6 +24,9 @@ type HttpTransportMethod struct {
 	failPostTime time.Time
 	ctx          *DronaCtx
 	hClient      *http.Client
+
+	// number of ranges to download in parallel
+	parallelRanges int
 }
 
 func (ep *HttpTransportMethod) Action(req *DronaRequest) error {
@@ -88,6 +92,13 @@ func (ep *HttpTransportMethod) WithLogging(onoff bool) error {
 	return nil
 }
 
+// WithParallelRanges splits downloads of large objects into up to n ranges
+// which are downloaded in parallel if the server supports range requests
+func (ep *HttpTransportMethod) WithParallelRanges(n int) error {
+	ep.parallelRanges = n
+	return nil
+}
+
 // File upload to HTTP Datastore
 func (ep *HttpTransportMethod) processHttpUpload(req *DronaRequest) (error, int) {
 	postUrl := ep.hurl + "/" + ep.path
@@ -139,7 +150,12 @@ func (ep *HttpTransportMethod) processHttpDownload(req *DronaRequest) (error, in
 			}
 		}(req, prgChan)
 	}
-	resp := zedHttp.ExecCmd("get", file, "", req.objloc, req.sizelimit, prgChan, ep.hClient)
+	ctx := req.cancelContext
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	resp := zedHttp.GetFile(ctx, file, req.objloc, req.sizelimit,
+		ep.parallelRanges, prgChan, ep.hClient)
 	if resp.Error != nil {
 		return resp.Error, resp.BodyLength
 	}
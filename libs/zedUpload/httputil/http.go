@@ -5,6 +5,7 @@ package http
 
 import (
 	"bytes"
+	"context"
 	"crypto/tls"
 	"fmt"
 	"io"
@@ -14,7 +15,6 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
-	"strings"
 
 	"golang.org/x/net/html"
 )
@@ -115,64 +115,8 @@ func ExecCmd(cmd, host, remoteFile, localFile string, objSize int64,
 		}
 		return stats
 	case "get":
-		req, err := http.NewRequest(http.MethodGet, host, nil)
-		if err != nil {
-			stats.Error = fmt.Errorf("request failed for get %s: %s",
-				host, err)
-			return stats
-		}
-		req.Header.Set("User-Agent", userAgent)
-		req.Header.Set("Content-Type", "application/octet-stream")
-
-		resp, err := client.Do(req)
-		if err != nil {
-			stats.Error = fmt.Errorf("get failed for get %s: %s",
-				host, err)
-			return stats
-		}
-		if resp.StatusCode != 200 {
-			stats.Error = fmt.Errorf("bad response code for %s: %d",
-				host, resp.StatusCode)
-			return stats
-		}
-		tempLocalFile := localFile
-		index := strings.LastIndex(tempLocalFile, "/")
-		dir_err := os.MkdirAll(tempLocalFile[:index+1], 0755)
-		if dir_err != nil {
-			stats.Error = dir_err
-			return stats
-		}
-		local, fileErr := os.Create(localFile)
-		if fileErr != nil {
-			stats.Error = fileErr
-			return stats
-		}
-		defer local.Close()
-		defer resp.Body.Close()
-		chunkSize := SingleMB
-		var written, copiedSize int64
-		stats.Size = objSize
-		for {
-			var copyErr error
-			if written, copyErr = io.CopyN(local, resp.Body, chunkSize); copyErr != nil && copyErr != io.EOF {
-				stats.Error = copyErr
-				return stats
-			}
-			copiedSize += written
-			if written != chunkSize {
-				// Must have reached EOF
-				break
-			}
-			stats.Asize = copiedSize
-			if prgNotify != nil {
-				select {
-				case prgNotify <- stats:
-				default: //ignore we cannot write
-				}
-			}
-		}
-		stats.BodyLength = int(resp.ContentLength)
-		return stats
+		return GetFile(context.Background(), host, localFile, objSize, 1,
+			prgNotify, client)
 	case "post":
 		file, err := os.Open(localFile)
 		if err != nil {
@@ -0,0 +1,552 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	// CheckpointSuffix is appended to the name of the local file to get
+	// the name of the file recording how far the download of it got
+	CheckpointSuffix = ".progress"
+	// checkpointInterval is how much we download between checkpoints
+	checkpointInterval = 16 * SingleMB
+)
+
+// minPartSize is the smallest range worth fetching in parallel
+var minPartSize = 64 * SingleMB
+
+// part is the range [Start, End) of the object of which the first Done
+// bytes have been downloaded. End is -1 if the size is not known.
+type part struct {
+	Start int64 `json:"start"`
+	End   int64 `json:"end"`
+	Done  int64 `json:"done"`
+}
+
+func (p part) complete() bool {
+	return p.End >= 0 && p.Start+p.Done >= p.End
+}
+
+// checkpoint is what we know about a partial download
+type checkpoint struct {
+	URL          string `json:"url"`
+	ETag         string `json:"etag,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+	// Ranges is set if the server supports range requests
+	Ranges bool   `json:"ranges"`
+	Size   int64  `json:"size"`
+	Parts  []part `json:"parts"`
+}
+
+func newCheckpoint(url string, size int64) *checkpoint {
+	return &checkpoint{URL: url, Size: size, Parts: []part{{End: size}}}
+}
+
+// CheckpointFile returns the name of the checkpoint of localFile
+func CheckpointFile(localFile string) string {
+	return localFile + CheckpointSuffix
+}
+
+// CanResume checks if there is a partial download of localFile which a
+// later GetFile can resume from
+func CanResume(localFile string) bool {
+	cp, err := readCheckpoint(localFile)
+	return err == nil && cp.Ranges
+}
+
+func readCheckpoint(localFile string) (*checkpoint, error) {
+	data, err := ioutil.ReadFile(CheckpointFile(localFile))
+	if err != nil {
+		return nil, err
+	}
+	var cp checkpoint
+	if err := json.Unmarshal(data, &cp); err != nil {
+		return nil, err
+	}
+	if len(cp.Parts) == 0 {
+		return nil, fmt.Errorf("no parts in checkpoint of %s", localFile)
+	}
+	return &cp, nil
+}
+
+// loadCheckpoint returns the checkpoint of the partial download of url into
+// localFile if it can be trusted
+func loadCheckpoint(localFile, url string) *checkpoint {
+	cp, err := readCheckpoint(localFile)
+	if err != nil || cp.URL != url || !cp.Ranges {
+		return nil
+	}
+	info, err := os.Stat(localFile)
+	if err != nil {
+		return nil
+	}
+	for _, p := range cp.Parts {
+		if p.Done < 0 || p.Start+p.Done > info.Size() {
+			return nil
+		}
+	}
+	return cp
+}
+
+// save atomically replaces the checkpoint of localFile. The caller must
+// have synced the data it covers.
+func (cp *checkpoint) save(localFile string) error {
+	data, err := json.Marshal(cp)
+	if err != nil {
+		return err
+	}
+	tmpFile := CheckpointFile(localFile) + ".tmp"
+	f, err := os.Create(tmpFile)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmpFile, CheckpointFile(localFile))
+}
+
+// split divides the object into up to parallel parts of at least
+// minPartSize, keeping what the first part already has
+func (cp *checkpoint) split(parallel int) {
+	n := int64(parallel)
+	if cp.Size/minPartSize < n {
+		n = cp.Size / minPartSize
+	}
+	if n < 2 {
+		return
+	}
+	partSize := cp.Size / n
+	done := cp.Parts[0].Done
+	if done > partSize {
+		// no point in splitting what is almost done
+		return
+	}
+	cp.Parts = nil
+	for i := int64(0); i < n; i++ {
+		cp.Parts = append(cp.Parts, part{Start: i * partSize, End: (i + 1) * partSize})
+	}
+	cp.Parts[0].Done = done
+	cp.Parts[n-1].End = cp.Size
+}
+
+// validator returns what to put in If-Range to make sure we resume the same
+// object. Weak ETags can't be used for that.
+func (cp *checkpoint) validator() string {
+	if cp.ETag != "" && !strings.HasPrefix(cp.ETag, "W/") {
+		return cp.ETag
+	}
+	return cp.LastModified
+}
+
+// parseContentRange parses "bytes first-last/size" where size can be "*"
+// in which case it returns -1
+func parseContentRange(value string) (int64, int64, int64, error) {
+	var first, last, size int64
+	var err error
+	spec := strings.TrimPrefix(value, "bytes ")
+	slash := strings.Index(spec, "/")
+	dash := strings.Index(spec, "-")
+	if spec == value || slash < 0 || dash < 0 || dash > slash {
+		return 0, 0, 0, fmt.Errorf("bad Content-Range %q", value)
+	}
+	if first, err = strconv.ParseInt(spec[:dash], 10, 64); err != nil {
+		return 0, 0, 0, fmt.Errorf("bad Content-Range %q: %s", value, err)
+	}
+	if last, err = strconv.ParseInt(spec[dash+1:slash], 10, 64); err != nil {
+		return 0, 0, 0, fmt.Errorf("bad Content-Range %q: %s", value, err)
+	}
+	if spec[slash+1:] == "*" {
+		size = -1
+	} else if size, err = strconv.ParseInt(spec[slash+1:], 10, 64); err != nil {
+		return 0, 0, 0, fmt.Errorf("bad Content-Range %q: %s", value, err)
+	}
+	if last < first || (size >= 0 && last >= size) {
+		return 0, 0, 0, fmt.Errorf("bad Content-Range %q", value)
+	}
+	return first, last, size, nil
+}
+
+// fileLock makes sure that an attempt which was given up on is done with a
+// file before the next one resumes it
+type fileLock struct {
+	sync.Mutex
+	users int
+}
+
+var (
+	fileLocksMutex sync.Mutex
+	fileLocks      = make(map[string]*fileLock)
+)
+
+func lockFile(name string) func() {
+	fileLocksMutex.Lock()
+	lock, ok := fileLocks[name]
+	if !ok {
+		lock = &fileLock{}
+		fileLocks[name] = lock
+	}
+	lock.users++
+	fileLocksMutex.Unlock()
+	lock.Lock()
+	return func() {
+		lock.Unlock()
+		fileLocksMutex.Lock()
+		lock.users--
+		if lock.users == 0 {
+			delete(fileLocks, name)
+		}
+		fileLocksMutex.Unlock()
+	}
+}
+
+// rangedGet is a download in progress
+type rangedGet struct {
+	sync.Mutex
+	ctx       context.Context
+	url       string
+	client    *http.Client
+	local     *os.File
+	localFile string
+	cp        *checkpoint
+	// unsaved is how much we downloaded since the last checkpoint
+	unsaved int64
+	// changed is set if the object changed while fetching the parts
+	changed   bool
+	stats     UpdateStats
+	prgNotify NotifChan
+}
+
+// GetFile downloads url into localFile. If the server supports range
+// requests the download resumes where an earlier attempt left off, as
+// recorded in the checkpoint next to localFile, and a new download of a
+// large object is split into up to parallel ranges which are fetched
+// concurrently. The checkpoint is removed once the download is complete.
+// Cancelling ctx aborts the download leaving the checkpoint behind.
+func GetFile(ctx context.Context, url, localFile string, objSize int64,
+	parallel int, prgNotify NotifChan, client *http.Client) UpdateStats {
+
+	stats := UpdateStats{Size: objSize}
+	if client == nil {
+		client = getHttpClient()
+	}
+	defer lockFile(localFile)()
+	if err := os.MkdirAll(filepath.Dir(localFile), 0755); err != nil {
+		stats.Error = err
+		return stats
+	}
+	cp := loadCheckpoint(localFile, url)
+	flags := os.O_RDWR | os.O_CREATE
+	if cp == nil {
+		cp = newCheckpoint(url, -1)
+		flags |= os.O_TRUNC
+	}
+	local, err := os.OpenFile(localFile, flags, 0644)
+	if err != nil {
+		stats.Error = err
+		return stats
+	}
+	defer local.Close()
+	g := &rangedGet{
+		ctx:       ctx,
+		url:       url,
+		client:    client,
+		local:     local,
+		localFile: localFile,
+		cp:        cp,
+		stats:     stats,
+		prgNotify: prgNotify,
+	}
+	for _, p := range cp.Parts {
+		g.stats.Asize += p.Done
+	}
+	err = g.run(parallel)
+	if err != nil {
+		g.stats.Error = fmt.Errorf("get failed for %s: %s", url, err)
+		if g.cp.Ranges {
+			if err := g.checkpoint(); err != nil {
+				g.stats.Error = fmt.Errorf("%s; saving checkpoint failed: %s",
+					g.stats.Error, err)
+			}
+		} else {
+			// nothing to resume from
+			os.Remove(CheckpointFile(localFile))
+		}
+		return g.stats
+	}
+	os.Remove(CheckpointFile(localFile))
+	g.stats.BodyLength = int(g.cp.Size)
+	return g.stats
+}
+
+// run fetches the parts which aren't complete. The first request, with
+// an open ended range, tells us whether the server supports ranges.
+func (g *rangedGet) run(parallel int) error {
+	first := -1
+	for i, p := range g.cp.Parts {
+		if !p.complete() {
+			first = i
+			break
+		}
+	}
+	if first < 0 {
+		return nil
+	}
+	resumed := g.cp.Ranges
+	offset := g.cp.Parts[first].Start + g.cp.Parts[first].Done
+	resp, err := g.request(g.ctx, offset, -1)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+		start, _, size, err := parseContentRange(resp.Header.Get("Content-Range"))
+		if err != nil {
+			return err
+		}
+		if start != offset {
+			return fmt.Errorf("asked for range from %d got %d", offset, start)
+		}
+		if resumed && size != g.cp.Size {
+			// changed under us, but kept its validators
+			g.reset(-1)
+			return fmt.Errorf("size changed from %d to %d",
+				g.cp.Size, size)
+		}
+		if !resumed {
+			g.cp.Ranges = true
+			g.cp.Size = size
+			g.cp.Parts[0].End = size
+			g.cp.ETag = resp.Header.Get("ETag")
+			g.cp.LastModified = resp.Header.Get("Last-Modified")
+			if size >= 0 {
+				g.cp.split(parallel)
+			}
+		}
+	case http.StatusOK:
+		// either the server doesn't support ranges or the object
+		// changed since the checkpoint; start over
+		g.reset(resp.ContentLength)
+		// unless we asked with If-Range a 200 means no ranges
+		g.cp.Ranges = resumed && resp.Header.Get("Accept-Ranges") == "bytes"
+		g.cp.ETag = resp.Header.Get("ETag")
+		g.cp.LastModified = resp.Header.Get("Last-Modified")
+		first = 0
+	case http.StatusRequestedRangeNotSatisfiable:
+		// the object must have shrunk, start over next time
+		g.reset(-1)
+		return fmt.Errorf("range from %d not satisfiable", offset)
+	default:
+		return fmt.Errorf("bad response code: %d", resp.StatusCode)
+	}
+	if g.stats.Size <= 0 {
+		g.stats.Size = g.cp.Size
+	}
+
+	ctx, cancel := context.WithCancel(g.ctx)
+	defer cancel()
+	errs := make(chan error, len(g.cp.Parts))
+	var wg sync.WaitGroup
+	for i, p := range g.cp.Parts {
+		if i == first || p.complete() {
+			continue
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if err := g.fetchPart(ctx, i); err != nil {
+				errs <- err
+				cancel()
+			}
+		}(i)
+	}
+	if err := g.copyPart(first, resp.Body); err != nil {
+		errs <- err
+		cancel()
+	}
+	// close it now to stop reading what belongs to the other parts
+	resp.Body.Close()
+	wg.Wait()
+	close(errs)
+	if g.changed {
+		g.reset(-1)
+	}
+	if err := <-errs; err != nil {
+		if g.ctx.Err() != nil {
+			return g.ctx.Err()
+		}
+		return err
+	}
+	return nil
+}
+
+// request asks for the object from first to last, or to the end if last
+// is negative, making sure we get the same object when resuming
+func (g *rangedGet) request(ctx context.Context, first, last int64) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, g.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set("Content-Type", "application/octet-stream")
+	if last < 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", first))
+	} else {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", first, last))
+	}
+	if g.cp.Ranges {
+		if validator := g.cp.validator(); validator != "" {
+			req.Header.Set("If-Range", validator)
+		}
+	}
+	return g.client.Do(req)
+}
+
+// fetchPart requests and copies one of the parts other than the first
+func (g *rangedGet) fetchPart(ctx context.Context, i int) error {
+	g.Lock()
+	p := g.cp.Parts[i]
+	g.Unlock()
+	offset := p.Start + p.Done
+	resp, err := g.request(ctx, offset, p.End-1)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusPartialContent {
+		if resp.StatusCode == http.StatusOK {
+			// the object changed since the first request
+			g.Lock()
+			g.changed = true
+			g.Unlock()
+		}
+		return fmt.Errorf("bad response code for range from %d: %d",
+			offset, resp.StatusCode)
+	}
+	start, last, _, err := parseContentRange(resp.Header.Get("Content-Range"))
+	if err != nil {
+		return err
+	}
+	if start != offset || last != p.End-1 {
+		return fmt.Errorf("asked for range %d-%d got %d-%d",
+			offset, p.End-1, start, last)
+	}
+	return g.copyPart(i, resp.Body)
+}
+
+// copyPart writes what we read from body into part i until it is complete
+func (g *rangedGet) copyPart(i int, body io.Reader) error {
+	buf := make([]byte, 32*1024)
+	for {
+		g.Lock()
+		p := g.cp.Parts[i]
+		g.Unlock()
+		if p.complete() {
+			return nil
+		}
+		want := int64(len(buf))
+		if p.End >= 0 && p.End-p.Start-p.Done < want {
+			want = p.End - p.Start - p.Done
+		}
+		n, err := body.Read(buf[:want])
+		if n > 0 {
+			if _, err := g.local.WriteAt(buf[:n], p.Start+p.Done); err != nil {
+				return err
+			}
+			if err := g.advance(i, int64(n)); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			g.Lock()
+			defer g.Unlock()
+			p = g.cp.Parts[i]
+			if p.End < 0 {
+				// now we know the size
+				g.cp.Size = p.Start + p.Done
+				g.cp.Parts[i].End = g.cp.Size
+				return nil
+			}
+			if !p.complete() {
+				return io.ErrUnexpectedEOF
+			}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// advance records that n more bytes of part i were written
+func (g *rangedGet) advance(i int, n int64) error {
+	g.Lock()
+	defer g.Unlock()
+	g.cp.Parts[i].Done += n
+	before := g.stats.Asize
+	g.stats.Asize += n
+	g.unsaved += n
+	if g.unsaved >= checkpointInterval && g.cp.Ranges {
+		if err := g.checkpointLocked(); err != nil {
+			return err
+		}
+	}
+	if g.prgNotify != nil && before/SingleMB != g.stats.Asize/SingleMB {
+		select {
+		case g.prgNotify <- g.stats:
+		default: //ignore we cannot write
+		}
+	}
+	return nil
+}
+
+func (g *rangedGet) checkpoint() error {
+	g.Lock()
+	defer g.Unlock()
+	return g.checkpointLocked()
+}
+
+// checkpointLocked syncs what we downloaded and records it
+func (g *rangedGet) checkpointLocked() error {
+	if err := g.local.Sync(); err != nil {
+		return err
+	}
+	if err := g.cp.save(g.localFile); err != nil {
+		return err
+	}
+	g.unsaved = 0
+	return nil
+}
+
+// reset throws away what we downloaded, and the checkpoint, to start over
+// with an object of the given size. Only called while no parts are being
+// fetched.
+func (g *rangedGet) reset(size int64) {
+	g.cp = newCheckpoint(g.url, size)
+	g.stats.Asize = 0
+	g.unsaved = 0
+	os.Remove(CheckpointFile(g.localFile))
+	g.local.Truncate(0)
+}
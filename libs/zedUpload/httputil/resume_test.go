@@ -0,0 +1,291 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testServer serves content, with or without range support, and cuts the
+// first failures responses short after cutAfter bytes
+type testServer struct {
+	sync.Mutex
+	content  []byte
+	etag     string
+	ranges   bool
+	failures int
+	cutAfter int
+	requests []string // the Range headers we got
+}
+
+func (s *testServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.Lock()
+	s.requests = append(s.requests, r.Header.Get("Range"))
+	content, etag, ranges := s.content, s.etag, s.ranges
+	if s.failures > 0 {
+		s.failures--
+		w = &cutWriter{ResponseWriter: w, left: s.cutAfter}
+	}
+	s.Unlock()
+	if !ranges {
+		w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+		w.WriteHeader(http.StatusOK)
+		w.Write(content)
+		return
+	}
+	w.Header().Set("ETag", etag)
+	http.ServeContent(w, r, "blob", time.Time{}, bytes.NewReader(content))
+}
+
+// cutWriter drops the connection after left bytes of the body
+type cutWriter struct {
+	http.ResponseWriter
+	left int
+}
+
+func (w *cutWriter) Write(b []byte) (int, error) {
+	if len(b) > w.left {
+		b = b[:w.left]
+	}
+	n, _ := w.ResponseWriter.Write(b)
+	w.left -= n
+	if w.left == 0 {
+		w.ResponseWriter.(http.Flusher).Flush()
+		panic(http.ErrAbortHandler)
+	}
+	return n, nil
+}
+
+func testContent(size int) []byte {
+	content := make([]byte, size)
+	rand.New(rand.NewSource(int64(size))).Read(content)
+	return content
+}
+
+func TestGetFile(t *testing.T) {
+	defer func(size int64) { minPartSize = size }(minPartSize)
+	minPartSize = SingleMB
+
+	testMatrix := map[string]struct {
+		ranges   bool
+		failures int
+		parallel int
+		attempts int
+		resumes  bool
+	}{
+		"no failures": {
+			ranges:   true,
+			parallel: 1,
+			attempts: 1,
+		},
+		"resume": {
+			ranges:   true,
+			failures: 2,
+			parallel: 1,
+			attempts: 3,
+			resumes:  true,
+		},
+		"no ranges": {
+			failures: 2,
+			parallel: 1,
+			attempts: 3,
+		},
+		"parallel": {
+			ranges:   true,
+			parallel: 4,
+			attempts: 1,
+		},
+		"parallel resume": {
+			ranges:   true,
+			failures: 1,
+			parallel: 4,
+			attempts: 2,
+			resumes:  true,
+		},
+		"parallel no ranges": {
+			parallel: 4,
+			attempts: 1,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		dir, err := ioutil.TempDir("", "httputil")
+		if err != nil {
+			t.Fatalf("can't create temp dir %v", err)
+		}
+		content := testContent(4*int(SingleMB) + 12345)
+		ts := &testServer{
+			content:  content,
+			etag:     `"v1"`,
+			ranges:   test.ranges,
+			failures: test.failures,
+			cutAfter: int(SingleMB) / 2,
+		}
+		server := httptest.NewServer(ts)
+		localFile := filepath.Join(dir, "pending", "blob")
+		attempts := 0
+		for {
+			attempts++
+			stats := GetFile(context.Background(), server.URL, localFile,
+				int64(len(content)), test.parallel, nil, nil)
+			if stats.Error == nil {
+				break
+			}
+			if attempts == test.attempts {
+				t.Errorf("%s: attempt %d failed: %v", testname, attempts, stats.Error)
+				break
+			}
+			if CanResume(localFile) != test.resumes {
+				t.Errorf("%s: CanResume after attempt %d is %t",
+					testname, attempts, !test.resumes)
+			}
+		}
+		if attempts != test.attempts {
+			t.Errorf("%s: took %d attempts expected %d", testname, attempts, test.attempts)
+		}
+		got, err := ioutil.ReadFile(localFile)
+		if err != nil || !bytes.Equal(got, content) {
+			t.Errorf("%s: got %d bytes (%v) expected %d", testname, len(got), err, len(content))
+		}
+		if _, err := os.Stat(CheckpointFile(localFile)); err == nil {
+			t.Errorf("%s: checkpoint left behind", testname)
+		}
+		resumed := false
+		for _, r := range ts.requests {
+			if r != "bytes=0-" {
+				resumed = true
+			}
+		}
+		if test.parallel == 1 && resumed != test.resumes {
+			t.Errorf("%s: requests %v resumed %t", testname, ts.requests, resumed)
+		}
+		if test.parallel > 1 && test.ranges && len(ts.requests) < test.parallel {
+			t.Errorf("%s: only %d requests for %d parts", testname,
+				len(ts.requests), test.parallel)
+		}
+		server.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestGetFileChanged(t *testing.T) {
+	dir, err := ioutil.TempDir("", "httputil")
+	if err != nil {
+		t.Fatalf("can't create temp dir %v", err)
+	}
+	defer os.RemoveAll(dir)
+	ts := &testServer{
+		content:  testContent(3 * int(SingleMB)),
+		etag:     `"v1"`,
+		ranges:   true,
+		failures: 1,
+		cutAfter: int(SingleMB),
+	}
+	server := httptest.NewServer(ts)
+	defer server.Close()
+	localFile := filepath.Join(dir, "blob")
+	stats := GetFile(context.Background(), server.URL, localFile, 0, 1, nil, nil)
+	if stats.Error == nil || !CanResume(localFile) {
+		t.Fatalf("first attempt didn't leave a partial download: %v", stats.Error)
+	}
+
+	// the If-Range makes the server send all of the new content
+	newContent := testContent(2*int(SingleMB) + 1)
+	ts.Lock()
+	ts.content, ts.etag = newContent, `"v2"`
+	ts.Unlock()
+	stats = GetFile(context.Background(), server.URL, localFile, 0, 1, nil, nil)
+	if stats.Error != nil {
+		t.Fatalf("second attempt failed: %v", stats.Error)
+	}
+	got, err := ioutil.ReadFile(localFile)
+	if err != nil || !bytes.Equal(got, newContent) {
+		t.Errorf("got %d bytes (%v) expected the %d bytes of the new content",
+			len(got), err, len(newContent))
+	}
+	if stats.BodyLength != len(newContent) {
+		t.Errorf("BodyLength %d expected %d", stats.BodyLength, len(newContent))
+	}
+}
+
+func TestGetFileCancel(t *testing.T) {
+	dir, err := ioutil.TempDir("", "httputil")
+	if err != nil {
+		t.Fatalf("can't create temp dir %v", err)
+	}
+	defer os.RemoveAll(dir)
+	content := testContent(2 * int(SingleMB))
+	stall := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Range", "bytes 0-"+strconv.Itoa(len(content)-1)+"/"+strconv.Itoa(len(content)))
+		w.WriteHeader(http.StatusPartialContent)
+		w.Write(content[:SingleMB])
+		w.(http.Flusher).Flush()
+		<-stall
+	}))
+	defer server.Close()
+	defer close(stall)
+	localFile := filepath.Join(dir, "blob")
+	ctx, cancel := context.WithCancel(context.Background())
+	prgNotify := make(NotifChan, 1)
+	go func() {
+		// cancel once we see progress
+		<-prgNotify
+		cancel()
+	}()
+	stats := GetFile(ctx, server.URL, localFile, 0, 1, prgNotify, nil)
+	if stats.Error == nil {
+		t.Fatalf("cancelled download succeeded")
+	}
+	if !CanResume(localFile) {
+		t.Errorf("cancelled download can't be resumed")
+	}
+	cp, err := readCheckpoint(localFile)
+	if err != nil || cp.Parts[0].Done != SingleMB {
+		t.Errorf("checkpoint %+v (%v) expected %d bytes done", cp, err, SingleMB)
+	}
+}
+
+func TestParseContentRange(t *testing.T) {
+	testMatrix := map[string]struct {
+		value string
+		first int64
+		last  int64
+		size  int64
+		fail  bool
+	}{
+		"range":         {value: "bytes 10-19/100", first: 10, last: 19, size: 100},
+		"unknown size":  {value: "bytes 0-9/*", first: 0, last: 9, size: -1},
+		"unsatisfiable": {value: "bytes */100", fail: true},
+		"no unit":       {value: "10-19/100", fail: true},
+		"backwards":     {value: "bytes 19-10/100", fail: true},
+		"past the end":  {value: "bytes 10-100/100", fail: true},
+		"empty":         {value: "", fail: true},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		first, last, size, err := parseContentRange(test.value)
+		if (err != nil) != test.fail {
+			t.Errorf("%s: unexpected error %v", testname, err)
+		}
+		if test.fail {
+			continue
+		}
+		if first != test.first || last != test.last || size != test.size {
+			t.Errorf("%s: got %d-%d/%d expected %d-%d/%d", testname,
+				first, last, size, test.first, test.last, test.size)
+		}
+	}
+}
@@ -790,6 +790,11 @@ func checkAppEntry(entry *inputEntry) string {
 				entry.source = appSplitArr[1]
 				if du, ok := domainUUID[entry.source]; ok {
 					appuuid = du.appUUID
+				} else if parts := strings.SplitN(entry.source, ".", 4); len(parts) == 4 {
+					// the additional containers of a pod log as <domain>.<container>
+					if du, ok := domainUUID[strings.Join(parts[:3], ".")]; ok {
+						appuuid = du.appUUID
+					}
 				}
 			}
 		}
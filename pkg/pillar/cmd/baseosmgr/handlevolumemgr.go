@@ -17,7 +17,10 @@ func MaybeAddContentTreeConfig(ctx *baseOsMgrContext, ctc *types.ContentTreeConf
 		log.Functionf("Content tree config exists for %s", ctc.Key())
 		return false
 	}
-	publishContentTreeConfig(ctx, ctc)
+	// volumemgr must not evict the EVE images we may fall back to
+	config := *ctc
+	config.Pinned = true
+	publishContentTreeConfig(ctx, &config)
 	log.Functionf("MaybeAddContentTreeConfig for %s Done", ctc.Key())
 	return true
 }
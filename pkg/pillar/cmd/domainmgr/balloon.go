@@ -0,0 +1,122 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Vary the memory of the domains with a Memory to MaxMem range based
+// on how much memory the host has left. Memory is what zedmanager
+// accounts for when admitting an app hence is never taken away.
+
+package domainmgr
+
+import (
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/hypervisor"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+const (
+	// Below this much free host memory we take memory back from the domains
+	balloonLowFreePercent = 10
+	// Above this much free host memory we give memory to the domains
+	balloonHighFreePercent = 25
+	// Least we change the memory of a domain by in one go, in kbytes
+	balloonMinStep = 64 * 1024
+)
+
+// balloonEntry is what we last ballooned a domain to in kbytes since it
+// booted at bootTime
+type balloonEntry struct {
+	bootTime time.Time
+	target   int
+}
+
+// balloonTargets is indexed by the key of the DomainStatus. Only used from
+// the metrics goroutine hence domain starts are noticed from the BootTime.
+type balloonTargets map[string]balloonEntry
+
+// balloonTarget returns the memory in kbytes the domain should have given
+// what it has now (known is false if we never ballooned it) and the free
+// host memory in kbytes out of total
+func balloonTarget(config types.DomainConfig, current int, known bool,
+	freeKB uint64, totalKB uint64) int {
+
+	if !known {
+		// Domains are ballooned down to Memory when started
+		return config.Memory
+	}
+	step := (config.MaxMem - config.Memory) / 4
+	if step < balloonMinStep {
+		step = balloonMinStep
+	}
+	target := current
+	if freeKB*100 < totalKB*balloonLowFreePercent {
+		target = current - step
+	} else if freeKB*100 > totalKB*balloonHighFreePercent {
+		target = current + step
+		// Don't go below the high watermark by doing so
+		if headroom := int(freeKB - totalKB*balloonHighFreePercent/100); step > headroom {
+			target = current + headroom
+		}
+	}
+	if target < config.Memory {
+		target = config.Memory
+	}
+	if target > config.MaxMem {
+		target = config.MaxMem
+	}
+	return target
+}
+
+// adjustBalloons moves the running domains which balloon one step towards
+// their targets given the memory the host has left
+func adjustBalloons(ctx *domainContext, hyper hypervisor.Hypervisor,
+	hm types.HostMemory) {
+
+	if hm.TotalMemoryMB == 0 {
+		return
+	}
+	totalKB := hm.TotalMemoryMB << 10
+	freeKB := hm.FreeMemoryMB << 10
+	seen := make(map[string]bool)
+	for _, st := range ctx.pubDomainStatus.GetAll() {
+		status := st.(types.DomainStatus)
+		key := status.Key()
+		config := lookupDomainConfig(ctx, key)
+		if config == nil || !config.Ballooning() ||
+			!status.Activated || status.State != types.RUNNING {
+			continue
+		}
+		seen[key] = true
+		entry, known := ctx.balloonTargets[key]
+		if known && !entry.bootTime.Equal(status.BootTime) {
+			// Restarted since hence back at Memory
+			log.Functionf("adjustBalloons(%s) restarted", key)
+			delete(ctx.balloonTargets, key)
+			known = false
+		}
+		current := entry.target
+		target := balloonTarget(*config, current, known, freeKB, totalKB)
+		if known && target == current {
+			continue
+		}
+		log.Functionf("adjustBalloons(%s) from %d to %d kbytes; host free %d of %d MB",
+			key, current, target, hm.FreeMemoryMB, hm.TotalMemoryMB)
+		if err := hyper.Task(&status).SetMemory(status.DomainName, status.DomainId, target); err != nil {
+			log.Warnf("adjustBalloons(%s) failed: %s", key, err)
+			continue
+		}
+		ctx.balloonTargets[key] = balloonEntry{
+			bootTime: status.BootTime,
+			target:   target,
+		}
+		// Account for the change for the remaining domains
+		if known {
+			freeKB = uint64(int64(freeKB) - int64(target-current))
+		}
+	}
+	for key := range ctx.balloonTargets {
+		if !seen[key] {
+			delete(ctx.balloonTargets, key)
+		}
+	}
+}
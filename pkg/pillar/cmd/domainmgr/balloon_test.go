@@ -0,0 +1,38 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package domainmgr
+
+import (
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+func TestBalloonTarget(t *testing.T) {
+	// 1 to 2 GB hence steps of 256 MB
+	config := types.DomainConfig{VmConfig: types.VmConfig{Memory: 1 << 20, MaxMem: 2 << 20}}
+	const totalKB = 16 << 20
+	testMatrix := map[string]struct {
+		current  int
+		known    bool
+		freeKB   uint64
+		expected int
+	}{
+		"first time":       {known: false, freeKB: 8 << 20, expected: 1 << 20},
+		"grow":             {current: 1 << 20, known: true, freeKB: 8 << 20, expected: 1<<20 + 256<<10},
+		"grow to max":      {current: 2<<20 - 64<<10, known: true, freeKB: 8 << 20, expected: 2 << 20},
+		"grow to headroom": {current: 1 << 20, known: true, freeKB: 4<<20 + 100<<10, expected: 1<<20 + 100<<10},
+		"steady":           {current: 1<<20 + 256<<10, known: true, freeKB: 3 << 20, expected: 1<<20 + 256<<10},
+		"shrink":           {current: 2 << 20, known: true, freeKB: 1 << 20, expected: 2<<20 - 256<<10},
+		"shrink to min":    {current: 1<<20 + 64<<10, known: true, freeKB: 1 << 20, expected: 1 << 20},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		target := balloonTarget(config, test.current, test.known, test.freeKB, totalKB)
+		if target != test.expected {
+			t.Errorf("balloonTarget(%d, %t, %d) returned %d; expected %d",
+				test.current, test.known, test.freeKB, target, test.expected)
+		}
+	}
+}
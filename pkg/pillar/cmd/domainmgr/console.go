@@ -0,0 +1,337 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Capture the serial console of the running domains. What a domain writes
+// to it is kept in a ring buffer and forwarded line by line to the logs of
+// the app. Local clients, such as the remote console reached through
+// wstunnel, can attach on localhost:consolePortBase+AppNum; they first get
+// the content of the ring buffer and then the live output, and what they
+// write goes to the console.
+
+package domainmgr
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/containerd"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+const (
+	// consoleBufferSize is how much of the console output we keep
+	consoleBufferSize = 64 * 1024
+	// consolePortBase plus the AppNum is where one can attach
+	consolePortBase = 7000
+	// consoleRetryInterval is how long we wait to attach again after
+	// losing the console, e.g., when the domain reboots
+	consoleRetryInterval = 5 * time.Second
+	// consoleMaxLine is where we split overly long lines
+	consoleMaxLine = 4096
+	// consoleWriteTimeout is how long a client can block the output
+	consoleWriteTimeout = time.Second
+)
+
+// ringBuffer keeps the last bytes written to it
+type ringBuffer struct {
+	data []byte
+	next int // Where the next byte goes
+	full bool
+}
+
+func newRingBuffer(size int) *ringBuffer {
+	return &ringBuffer{data: make([]byte, size)}
+}
+
+func (r *ringBuffer) Write(p []byte) (int, error) {
+	n := len(p)
+	if n >= len(r.data) {
+		copy(r.data, p[n-len(r.data):])
+		r.next = 0
+		r.full = true
+		return n, nil
+	}
+	c := copy(r.data[r.next:], p)
+	if c < n {
+		copy(r.data, p[c:])
+		r.full = true
+	}
+	r.next = (r.next + n) % len(r.data)
+	if r.next == 0 {
+		r.full = true
+	}
+	return n, nil
+}
+
+// Bytes returns a copy of the content, oldest first
+func (r *ringBuffer) Bytes() []byte {
+	if !r.full {
+		return append([]byte{}, r.data[:r.next]...)
+	}
+	return append(append([]byte{}, r.data[r.next:]...), r.data[:r.next]...)
+}
+
+// domainConsole is the capture of the console of one domain
+type domainConsole struct {
+	sync.Mutex
+	domainName string
+	ring       *ringBuffer
+	console    io.ReadWriteCloser // nil while not attached
+	clients    map[net.Conn]bool
+	listener   net.Listener
+	logger     io.WriteCloser // nil if the output goes to the logs anyway
+	line       []byte         // Not forwarded to logger yet
+	stopped    bool
+	stop       chan struct{}
+}
+
+func newDomainConsole(domainName string, logger io.WriteCloser,
+	address string) (*domainConsole, error) {
+
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+	c := &domainConsole{
+		domainName: domainName,
+		ring:       newRingBuffer(consoleBufferSize),
+		clients:    make(map[net.Conn]bool),
+		listener:   listener,
+		logger:     logger,
+		stop:       make(chan struct{}),
+	}
+	go c.serve()
+	return c, nil
+}
+
+// startConsole captures the console of the domain if it has one
+func startConsole(ctx *domainContext, status *types.DomainStatus) {
+	task := hyper.Task(status)
+	console, logged, err := task.Console(status.DomainName, status.DomainId)
+	if err != nil {
+		log.Errorf("startConsole(%s) failed: %s", status.Key(), err)
+		return
+	}
+	if console == nil {
+		return
+	}
+	var logger io.WriteCloser
+	if !logged {
+		logger, err = containerd.GetLog().Open("guest_vm-" + status.DomainName)
+		if err != nil {
+			log.Errorf("startConsole(%s) no logger: %s", status.Key(), err)
+			console.Close()
+			return
+		}
+	}
+	port := consolePortBase + status.AppNum
+	c, err := newDomainConsole(status.DomainName, logger,
+		fmt.Sprintf("127.0.0.1:%d", port))
+	if err != nil {
+		log.Errorf("startConsole(%s) can't listen: %s", status.Key(), err)
+		if logger != nil {
+			logger.Close()
+		}
+		console.Close()
+		return
+	}
+	ctx.consolesLock.Lock()
+	if old, ok := ctx.consoles[status.Key()]; ok {
+		old.close()
+	}
+	ctx.consoles[status.Key()] = c
+	ctx.consolesLock.Unlock()
+	status.ConsolePort = port
+	log.Noticef("startConsole(%s) attach on port %d", status.Key(), port)
+	domainID := status.DomainId
+	go c.run(console, func() (io.ReadWriteCloser, error) {
+		console, _, err := task.Console(c.domainName, domainID)
+		return console, err
+	})
+}
+
+// stopConsole stops capturing the console of the domain, if we do
+func stopConsole(ctx *domainContext, status *types.DomainStatus) {
+	ctx.consolesLock.Lock()
+	c, ok := ctx.consoles[status.Key()]
+	delete(ctx.consoles, status.Key())
+	ctx.consolesLock.Unlock()
+	status.ConsolePort = 0
+	if ok {
+		log.Functionf("stopConsole(%s)", status.Key())
+		c.close()
+	}
+}
+
+// run captures the console until close; attaching again whenever it
+// goes away. The logger, if any, is closed once done.
+func (c *domainConsole) run(console io.ReadWriteCloser,
+	attach func() (io.ReadWriteCloser, error)) {
+
+	for {
+		if c.setConsole(console) {
+			c.capture(console)
+			c.setConsole(nil)
+		}
+		select {
+		case <-c.stop:
+			if c.logger != nil {
+				c.logger.Close()
+			}
+			return
+		case <-time.After(consoleRetryInterval):
+		}
+		var err error
+		if console, err = attach(); err != nil {
+			log.Functionf("domainConsole(%s) attach failed: %s",
+				c.domainName, err)
+			console = nil
+		}
+	}
+}
+
+// setConsole returns false if console is not used since we stopped
+func (c *domainConsole) setConsole(console io.ReadWriteCloser) bool {
+	c.Lock()
+	defer c.Unlock()
+	if console == nil {
+		if c.console != nil {
+			c.console.Close()
+			c.console = nil
+		}
+		return false
+	}
+	if c.stopped {
+		console.Close()
+		return false
+	}
+	c.console = console
+	return true
+}
+
+func (c *domainConsole) capture(console io.Reader) {
+	buf := make([]byte, 4096)
+	for {
+		n, err := console.Read(buf)
+		if n > 0 {
+			c.output(buf[:n])
+		}
+		if err != nil {
+			c.flushLine()
+			return
+		}
+	}
+}
+
+// output keeps and hands out what the domain wrote to its console
+func (c *domainConsole) output(p []byte) {
+	c.Lock()
+	c.ring.Write(p)
+	for client := range c.clients {
+		client.SetWriteDeadline(time.Now().Add(consoleWriteTimeout))
+		if _, err := client.Write(p); err != nil {
+			log.Functionf("domainConsole(%s) dropping client %s: %s",
+				c.domainName, client.RemoteAddr(), err)
+			client.Close()
+			delete(c.clients, client)
+		}
+	}
+	c.Unlock()
+	if c.logger == nil {
+		return
+	}
+	for _, b := range p {
+		switch b {
+		case '\n':
+			c.flushLine()
+		case '\r':
+		default:
+			c.line = append(c.line, b)
+			if len(c.line) >= consoleMaxLine {
+				c.flushLine()
+			}
+		}
+	}
+}
+
+func (c *domainConsole) flushLine() {
+	if len(c.line) == 0 {
+		return
+	}
+	if _, err := c.logger.Write(append(c.line, '\n')); err != nil {
+		log.Warnf("domainConsole(%s) log failed: %s", c.domainName, err)
+	}
+	c.line = c.line[:0]
+}
+
+func (c *domainConsole) serve() {
+	for {
+		client, err := c.listener.Accept()
+		if err != nil {
+			return
+		}
+		go c.attach(client)
+	}
+}
+
+// attach replays the ring buffer to the client and then passes what
+// it writes on to the console
+func (c *domainConsole) attach(client net.Conn) {
+	c.Lock()
+	if c.stopped {
+		c.Unlock()
+		client.Close()
+		return
+	}
+	client.SetWriteDeadline(time.Now().Add(consoleWriteTimeout))
+	if _, err := client.Write(c.ring.Bytes()); err != nil {
+		c.Unlock()
+		client.Close()
+		return
+	}
+	c.clients[client] = true
+	c.Unlock()
+	log.Functionf("domainConsole(%s) attached %s", c.domainName,
+		client.RemoteAddr())
+
+	buf := make([]byte, 1024)
+	for {
+		n, err := client.Read(buf)
+		if n > 0 {
+			c.Lock()
+			if c.console != nil {
+				c.console.Write(buf[:n])
+			}
+			c.Unlock()
+		}
+		if err != nil {
+			break
+		}
+	}
+	c.Lock()
+	delete(c.clients, client)
+	c.Unlock()
+	client.Close()
+}
+
+func (c *domainConsole) close() {
+	c.Lock()
+	defer c.Unlock()
+	if c.stopped {
+		return
+	}
+	c.stopped = true
+	close(c.stop)
+	c.listener.Close()
+	if c.console != nil {
+		// makes capture return
+		c.console.Close()
+	}
+	for client := range c.clients {
+		client.Close()
+	}
+	c.clients = make(map[net.Conn]bool)
+}
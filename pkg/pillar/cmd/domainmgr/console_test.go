@@ -0,0 +1,117 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package domainmgr
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/sirupsen/logrus"
+)
+
+func TestRingBuffer(t *testing.T) {
+	testMatrix := map[string]struct {
+		writes   []string
+		expected string
+	}{
+		"empty":      {expected: ""},
+		"partial":    {writes: []string{"ab", "c"}, expected: "abc"},
+		"exact":      {writes: []string{"abcd", "ef"}, expected: "abcdef"},
+		"wrapped":    {writes: []string{"abcd", "efgh"}, expected: "cdefgh"},
+		"oversized":  {writes: []string{"a", "bcdefghij"}, expected: "efghij"},
+		"many wraps": {writes: []string{"abc", "def", "ghi", "jk"}, expected: "fghijk"},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		r := newRingBuffer(6)
+		for _, w := range test.writes {
+			r.Write([]byte(w))
+		}
+		if got := string(r.Bytes()); got != test.expected {
+			t.Errorf("%s: got %q expected %q", testname, got, test.expected)
+		}
+	}
+}
+
+// testLogger collects what domainConsole forwards to the logs
+type testLogger struct {
+	sync.Mutex
+	bytes.Buffer
+}
+
+func (l *testLogger) Write(p []byte) (int, error) {
+	l.Lock()
+	defer l.Unlock()
+	return l.Buffer.Write(p)
+}
+
+func (l *testLogger) String() string {
+	l.Lock()
+	defer l.Unlock()
+	return l.Buffer.String()
+}
+
+func (l *testLogger) Close() error {
+	return nil
+}
+
+func TestDomainConsole(t *testing.T) {
+	log = base.NewSourceLogObject(logrus.StandardLogger(), "domainmgr", 0)
+	logger := &testLogger{}
+	c, err := newDomainConsole("test", logger, "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("newDomainConsole failed: %v", err)
+	}
+	domain, console := net.Pipe()
+	go c.run(console, func() (io.ReadWriteCloser, error) {
+		return nil, io.EOF
+	})
+
+	domain.Write([]byte("booting\r\nlogin: "))
+	client, err := net.Dial("tcp", c.listener.Addr().String())
+	if err != nil {
+		t.Fatalf("can't attach: %v", err)
+	}
+	defer client.Close()
+	client.SetDeadline(time.Now().Add(5 * time.Second))
+	reader := bufio.NewReader(client)
+	// the client gets the history first
+	for _, expected := range []string{"booting\r\n", "login: "} {
+		buf := make([]byte, len(expected))
+		if _, err := io.ReadFull(reader, buf); err != nil || string(buf) != expected {
+			t.Errorf("got %q (%v) expected %q", buf, err, expected)
+		}
+	}
+
+	// and then what both sides write
+	client.Write([]byte("root\n"))
+	buf := make([]byte, 5)
+	domain.SetDeadline(time.Now().Add(5 * time.Second))
+	if _, err := io.ReadFull(domain, buf); err != nil || string(buf) != "root\n" {
+		t.Errorf("console got %q (%v)", buf, err)
+	}
+	domain.Write([]byte("root\r\n# "))
+	if line, err := reader.ReadString('\n'); err != nil || line != "root\r\n" {
+		t.Errorf("client got %q (%v)", line, err)
+	}
+
+	c.close()
+	if _, err := domain.Read(buf); err == nil {
+		t.Errorf("console still attached after close")
+	}
+	// the partial last line goes out once the console is gone
+	expected := "booting\nlogin: root\n# \n"
+	for i := 0; i < 50 && logger.String() != expected; i++ {
+		time.Sleep(100 * time.Millisecond)
+	}
+	if logger.String() != expected {
+		t.Errorf("logged %q expected %q", logger.String(), expected)
+	}
+}
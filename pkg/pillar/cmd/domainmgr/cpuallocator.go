@@ -0,0 +1,296 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Keep track of which physical CPUs the domains are pinned to
+
+package domainmgr
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const sysfsNodeDir = "/sys/devices/system/node"
+
+// numaNode has the CPUs of a NUMA node. Nodes without CPUs are skipped
+// hence id, as in nodeN in sysfs, isn't necessarily the index of the node.
+type numaNode struct {
+	id   int
+	cpus []int
+}
+
+// cpuAllocator hands out physical CPUs to domains. Domains which get
+// dedicated CPUs have them exclusively, all on one NUMA node if possible.
+// Domains with an explicit CPU list may share those CPUs with each other
+// but not with the domains which have dedicated CPUs.
+// The methods are called from the per-domain goroutines hence the lock.
+type cpuAllocator struct {
+	sync.Mutex
+	nodes     []numaNode       // NUMA nodes which have CPUs
+	reserved  map[int]bool     // Never handed out; e.g., for EVE itself
+	dedicated map[string][]int // Indexed by DomainConfig key
+	shared    map[string][]int // Indexed by DomainConfig key
+}
+
+func newCPUAllocator(nodes []numaNode, reserved []int) *cpuAllocator {
+	alloc := &cpuAllocator{
+		nodes:     nodes,
+		reserved:  make(map[int]bool),
+		dedicated: make(map[string][]int),
+		shared:    make(map[string][]int),
+	}
+	for _, cpu := range reserved {
+		alloc.reserved[cpu] = true
+	}
+	return alloc
+}
+
+// usedCPUs returns all the CPUs in use by domains other than key
+func (alloc *cpuAllocator) usedCPUs(key string, dedicatedOnly bool) map[int]bool {
+	used := make(map[int]bool)
+	for k, cpus := range alloc.dedicated {
+		if k == key {
+			continue
+		}
+		for _, cpu := range cpus {
+			used[cpu] = true
+		}
+	}
+	if dedicatedOnly {
+		return used
+	}
+	for k, cpus := range alloc.shared {
+		if k == key {
+			continue
+		}
+		for _, cpu := range cpus {
+			used[cpu] = true
+		}
+	}
+	return used
+}
+
+// allocate picks count dedicated CPUs for the domain. They come from the
+// NUMA node with the most free CPUs which can fit all of them; if no node
+// can they are spread across nodes. Returns the CPUs and the id of their
+// NUMA node (-1 if spread). Calling it again for the same domain returns the same CPUs.
+func (alloc *cpuAllocator) allocate(key string, count int) ([]int, int, error) {
+	alloc.Lock()
+	defer alloc.Unlock()
+
+	if cpus, ok := alloc.dedicated[key]; ok && len(cpus) == count {
+		return cpus, alloc.nodeOf(cpus), nil
+	}
+	delete(alloc.dedicated, key)
+	delete(alloc.shared, key)
+
+	used := alloc.usedCPUs(key, false)
+	var free [][]int
+	total := 0
+	for _, node := range alloc.nodes {
+		var nodeFree []int
+		for _, cpu := range node.cpus {
+			if !used[cpu] && !alloc.reserved[cpu] {
+				nodeFree = append(nodeFree, cpu)
+			}
+		}
+		free = append(free, nodeFree)
+		total += len(nodeFree)
+	}
+	if count > total {
+		return nil, -1, fmt.Errorf("can't dedicate %d CPUs; only %d are free",
+			count, total)
+	}
+
+	best := -1
+	for i, nodeFree := range free {
+		if len(nodeFree) >= count &&
+			(best == -1 || len(nodeFree) > len(free[best])) {
+			best = i
+		}
+	}
+	var cpus []int
+	nodeID := -1
+	if best != -1 {
+		cpus = append(cpus, free[best][:count]...)
+		nodeID = alloc.nodes[best].id
+	} else {
+		for _, nodeFree := range free {
+			for _, cpu := range nodeFree {
+				if len(cpus) < count {
+					cpus = append(cpus, cpu)
+				}
+			}
+		}
+		sort.Ints(cpus)
+	}
+	alloc.dedicated[key] = cpus
+	return cpus, nodeID, nil
+}
+
+// reserve records an explicit list of CPUs for the domain. It fails if any
+// of them is dedicated to another domain, reserved or doesn't exist.
+func (alloc *cpuAllocator) reserve(key string, cpus []int) (int, error) {
+	alloc.Lock()
+	defer alloc.Unlock()
+
+	delete(alloc.dedicated, key)
+	delete(alloc.shared, key)
+
+	known := make(map[int]bool)
+	for _, node := range alloc.nodes {
+		for _, cpu := range node.cpus {
+			known[cpu] = true
+		}
+	}
+	used := alloc.usedCPUs(key, true)
+	for _, cpu := range cpus {
+		if !known[cpu] {
+			return -1, fmt.Errorf("CPU %d doesn't exist", cpu)
+		}
+		if alloc.reserved[cpu] {
+			return -1, fmt.Errorf("CPU %d is reserved for EVE", cpu)
+		}
+		if used[cpu] {
+			return -1, fmt.Errorf("CPU %d is dedicated to another app", cpu)
+		}
+	}
+	alloc.shared[key] = cpus
+	return alloc.nodeOf(cpus), nil
+}
+
+// release forgets the CPUs of the domain
+func (alloc *cpuAllocator) release(key string) {
+	alloc.Lock()
+	defer alloc.Unlock()
+
+	delete(alloc.dedicated, key)
+	delete(alloc.shared, key)
+}
+
+// nodeOf returns the id of the NUMA node of all the CPUs; -1 if they
+// span nodes
+func (alloc *cpuAllocator) nodeOf(cpus []int) int {
+	for _, node := range alloc.nodes {
+		inNode := make(map[int]bool)
+		for _, cpu := range node.cpus {
+			inNode[cpu] = true
+		}
+		all := true
+		for _, cpu := range cpus {
+			if !inNode[cpu] {
+				all = false
+				break
+			}
+		}
+		if all && len(cpus) != 0 {
+			return node.id
+		}
+	}
+	return -1
+}
+
+// parseCPUList parses the Linux/xen cpulist format, e.g., "0-3,6"
+func parseCPUList(list string) ([]int, error) {
+	var cpus []int
+	seen := make(map[int]bool)
+	for _, part := range strings.Split(strings.TrimSpace(list), ",") {
+		if part == "" {
+			continue
+		}
+		bounds := strings.SplitN(part, "-", 2)
+		first, err := strconv.Atoi(bounds[0])
+		if err != nil || first < 0 {
+			return nil, fmt.Errorf("bad CPU %s in %s", bounds[0], list)
+		}
+		last := first
+		if len(bounds) == 2 {
+			last, err = strconv.Atoi(bounds[1])
+			if err != nil || last < first {
+				return nil, fmt.Errorf("bad CPU range %s in %s", part, list)
+			}
+		}
+		for cpu := first; cpu <= last; cpu++ {
+			if !seen[cpu] {
+				seen[cpu] = true
+				cpus = append(cpus, cpu)
+			}
+		}
+	}
+	sort.Ints(cpus)
+	return cpus, nil
+}
+
+// formatCPUList is the inverse of parseCPUList; expects sorted CPUs
+func formatCPUList(cpus []int) string {
+	var parts []string
+	for i := 0; i < len(cpus); {
+		j := i
+		for j+1 < len(cpus) && cpus[j+1] == cpus[j]+1 {
+			j++
+		}
+		if j == i {
+			parts = append(parts, strconv.Itoa(cpus[i]))
+		} else {
+			parts = append(parts, fmt.Sprintf("%d-%d", cpus[i], cpus[j]))
+		}
+		i = j + 1
+	}
+	return strings.Join(parts, ",")
+}
+
+// getNUMANodes returns the NUMA nodes with CPUs as seen in sysfsDir.
+// Under xen dom0 only sees its own CPUs, hence unless sysfs accounts for
+// exactly ncpus CPUs we treat the host as a single node.
+func getNUMANodes(sysfsDir string, ncpus int) []numaNode {
+	var nodes []numaNode
+	count := 0
+	dirs, _ := filepath.Glob(filepath.Join(sysfsDir, "node[0-9]*"))
+	ids := make(map[string]int)
+	for _, dir := range dirs {
+		id, err := strconv.Atoi(strings.TrimPrefix(filepath.Base(dir), "node"))
+		if err != nil {
+			continue
+		}
+		ids[dir] = id
+	}
+	sort.Slice(dirs, func(i, j int) bool {
+		return ids[dirs[i]] < ids[dirs[j]]
+	})
+	for _, dir := range dirs {
+		id, ok := ids[dir]
+		if !ok {
+			continue
+		}
+		content, err := ioutil.ReadFile(filepath.Join(dir, "cpulist"))
+		if err != nil {
+			continue
+		}
+		cpus, err := parseCPUList(string(content))
+		if err != nil || len(cpus) == 0 {
+			continue
+		}
+		for _, cpu := range cpus {
+			if cpu >= ncpus {
+				count = -1
+			}
+		}
+		if count >= 0 {
+			count += len(cpus)
+		}
+		nodes = append(nodes, numaNode{id: id, cpus: cpus})
+	}
+	if count == ncpus && count != 0 {
+		return nodes
+	}
+	var all []int
+	for cpu := 0; cpu < ncpus; cpu++ {
+		all = append(all, cpu)
+	}
+	return []numaNode{{id: 0, cpus: all}}
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package domainmgr
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestParseFormatCPUList(t *testing.T) {
+	testMatrix := map[string]struct {
+		list      string
+		cpus      []int
+		formatted string
+		fail      bool
+	}{
+		"single":      {list: "3", cpus: []int{3}, formatted: "3"},
+		"range":       {list: "0-3", cpus: []int{0, 1, 2, 3}, formatted: "0-3"},
+		"mixed":       {list: "6,1-2,4", cpus: []int{1, 2, 4, 6}, formatted: "1-2,4,6"},
+		"overlapping": {list: "1-3,2-4\n", cpus: []int{1, 2, 3, 4}, formatted: "1-4"},
+		"empty":       {list: "", cpus: nil, formatted: ""},
+		"bad cpu":     {list: "1,a", fail: true},
+		"bad range":   {list: "3-1", fail: true},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		cpus, err := parseCPUList(test.list)
+		if test.fail {
+			if err == nil {
+				t.Errorf("parseCPUList(%s) should've failed", test.list)
+			}
+			continue
+		}
+		if err != nil || !reflect.DeepEqual(cpus, test.cpus) {
+			t.Errorf("parseCPUList(%s) returned %v, %v; expected %v", test.list, cpus, err, test.cpus)
+		}
+		if formatted := formatCPUList(cpus); formatted != test.formatted {
+			t.Errorf("formatCPUList(%v) returned %s; expected %s", cpus, formatted, test.formatted)
+		}
+	}
+}
+
+func TestCPUAllocator(t *testing.T) {
+	// two NUMA nodes with 4 CPUs each, CPU 0 left to EVE
+	alloc := newCPUAllocator([]numaNode{{id: 0, cpus: []int{0, 1, 2, 3}},
+		{id: 1, cpus: []int{4, 5, 6, 7}}}, []int{0})
+
+	// node 1 has the most free CPUs
+	cpus, node, err := alloc.allocate("app1", 2)
+	if err != nil || !reflect.DeepEqual(cpus, []int{4, 5}) || node != 1 {
+		t.Errorf("allocate app1 returned %v, %d, %v", cpus, node, err)
+	}
+	// asking again doesn't change anything
+	if again, _, _ := alloc.allocate("app1", 2); !reflect.DeepEqual(again, cpus) {
+		t.Errorf("allocate app1 again returned %v instead of %v", again, cpus)
+	}
+	// now node 0 has more free CPUs (1-3) than node 1 (6-7)
+	cpus, node, err = alloc.allocate("app2", 2)
+	if err != nil || !reflect.DeepEqual(cpus, []int{1, 2}) || node != 0 {
+		t.Errorf("allocate app2 returned %v, %d, %v", cpus, node, err)
+	}
+	// explicit CPUs can't overlap dedicated ones
+	if _, err := alloc.reserve("app3", []int{2, 3}); err == nil {
+		t.Errorf("reserve app3 should've failed for a CPU dedicated to app2")
+	}
+	if _, err := alloc.reserve("app3", []int{9}); err == nil {
+		t.Errorf("reserve app3 should've failed for a CPU that doesn't exist")
+	}
+	if _, err := alloc.reserve("app3", []int{0, 3}); err == nil {
+		t.Errorf("reserve app3 should've failed for the CPU left to EVE")
+	}
+	if node, err := alloc.reserve("app3", []int{3}); err != nil || node != 0 {
+		t.Errorf("reserve app3 returned %d, %v", node, err)
+	}
+	// only 6-7 are free; no node can fit 3 and there aren't 3 in total
+	if _, _, err := alloc.allocate("app4", 3); err == nil {
+		t.Errorf("allocate app4 should've failed with only 2 free CPUs")
+	}
+	alloc.release("app2")
+	// 1-2 and 6-7 are free, neither node fits 3 so they are spread
+	cpus, node, err = alloc.allocate("app4", 3)
+	if err != nil || !reflect.DeepEqual(cpus, []int{1, 2, 6}) || node != -1 {
+		t.Errorf("allocate app4 returned %v, %d, %v", cpus, node, err)
+	}
+
+	// node 1 has memory but no CPUs hence isn't in the list; the ids of
+	// the nodes after it are not their index
+	alloc = newCPUAllocator([]numaNode{{id: 0, cpus: []int{0, 1}},
+		{id: 2, cpus: []int{2, 3, 4}}}, nil)
+	cpus, node, err = alloc.allocate("app1", 2)
+	if err != nil || !reflect.DeepEqual(cpus, []int{2, 3}) || node != 2 {
+		t.Errorf("allocate app1 returned %v, %d, %v", cpus, node, err)
+	}
+	if node, err := alloc.reserve("app2", []int{4}); err != nil || node != 2 {
+		t.Errorf("reserve app2 returned %d, %v", node, err)
+	}
+}
+
+func TestGetNUMANodes(t *testing.T) {
+	dir, err := ioutil.TempDir("", "numa")
+	if err != nil {
+		t.Fatalf("can't create temp dir %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	// node1 has no CPUs, e.g., memory only
+	for node, cpulist := range map[string]string{"node0": "0-1\n", "node1": "\n", "node2": "2-3\n"} {
+		if err := os.MkdirAll(filepath.Join(dir, node), 0755); err != nil {
+			t.Fatalf("can't create %s %v", node, err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, node, "cpulist"), []byte(cpulist), 0644); err != nil {
+			t.Fatalf("can't write cpulist of %s %v", node, err)
+		}
+	}
+
+	expected := []numaNode{{id: 0, cpus: []int{0, 1}}, {id: 2, cpus: []int{2, 3}}}
+	if nodes := getNUMANodes(dir, 4); !reflect.DeepEqual(nodes, expected) {
+		t.Errorf("getNUMANodes returned %v", nodes)
+	}
+	// e.g., under xen where dom0 sees fewer CPUs than there are
+	expected = []numaNode{{id: 0, cpus: []int{0, 1, 2, 3, 4, 5, 6, 7}}}
+	if nodes := getNUMANodes(dir, 8); !reflect.DeepEqual(nodes, expected) {
+		t.Errorf("getNUMANodes with more CPUs returned %v", nodes)
+	}
+	expected = []numaNode{{id: 0, cpus: []int{0, 1}}}
+	if nodes := getNUMANodes(filepath.Join(dir, "missing"), 2); !reflect.DeepEqual(nodes, expected) {
+		t.Errorf("getNUMANodes without sysfs returned %v", nodes)
+	}
+}
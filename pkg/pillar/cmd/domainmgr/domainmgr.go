@@ -16,6 +16,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path"
+	"runtime"
 	"strconv"
 	"strings"
 	"sync"
@@ -51,6 +52,9 @@ const (
 	warningTime         = 40 * time.Second
 	containerRootfsPath = "rootfs/"
 	casClientType       = "containerd"
+	// How often we ask the guest agent about the guest; each time costs
+	// a few round trips to the guest hence less often than verifyStatus
+	guestInfoInterval = 5 * time.Minute
 )
 
 // Really a constant
@@ -96,6 +100,18 @@ type domainContext struct {
 	// Common CAS client which can be used by multiple routines.
 	// There is no shared data so its safe to be used by multiple goroutines
 	casClient cas.CAS
+	// Dedicate CPUs to domains activated from now on
+	pinCPUs      bool
+	cpuAllocator *cpuAllocator
+	// Only used from the metrics goroutine
+	balloonTargets         balloonTargets
+	ctrdClient             *containerd.Client
+	pubAppContainerMetrics pubsub.Publication
+	// Captured serial consoles by the key of the domain
+	consoles     map[string]*domainConsole
+	consolesLock sync.Mutex
+	// USB devices plugged into the host by UsbAddr
+	usbDevices map[string]usbDevice
 }
 
 func (ctx *domainContext) publishAssignableAdapters() {
@@ -187,10 +203,26 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 		usbAccess:           true,
 		domainBootRetryTime: 600,
 		pids:                make(map[int32]bool),
+		balloonTargets:      make(balloonTargets),
+		consoles:            make(map[string]*domainConsole),
 	}
 	aa := types.AssignableAdapters{}
 	domainCtx.assignableAdapters = &aa
 
+	ncpus := runtime.NumCPU()
+	if hostCPUMem, err := hyper.GetHostCPUMem(); err != nil {
+		log.Errorf("GetHostCPUMem failed, assuming %d CPUs: %s", ncpus, err)
+	} else {
+		ncpus = int(hostCPUMem.Ncpus)
+	}
+	// Leave CPU 0 to EVE unless that is all we have
+	var reservedCPUs []int
+	if ncpus > 1 {
+		reservedCPUs = []int{0}
+	}
+	domainCtx.cpuAllocator = newCPUAllocator(
+		getNUMANodes(sysfsNodeDir, ncpus), reservedCPUs)
+
 	// Allow only one concurrent domain create
 	domainCtx.createSema = sema.New(log, 1)
 	domainCtx.createSema.P(1)
@@ -226,6 +258,16 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	}
 	domainCtx.pubDomainMetric = pubDomainMetric
 
+	pubAppContainerMetrics, err := ps.NewPublication(
+		pubsub.PublicationOptions{
+			AgentName: agentName,
+			TopicType: types.AppContainerMetrics{},
+		})
+	if err != nil {
+		log.Fatal(err)
+	}
+	domainCtx.pubAppContainerMetrics = pubAppContainerMetrics
+
 	pubProcessMetric, err := ps.NewPublication(
 		pubsub.PublicationOptions{
 			AgentName: agentName,
@@ -527,6 +569,13 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	}
 	log.Functionf("Have %d assignable adapters", len(aa.IoBundleList))
 
+	usbChanges, err := watchUsbUevents()
+	if err != nil {
+		// we still pick up what is plugged in now
+		log.Errorf("No USB hot-plug events: %s", err)
+	}
+	rescanUsbDevices(&domainCtx)
+
 	// Subscribe to DomainConfig from zedmanager
 	subDomainConfig, err := ps.NewSubscription(
 		pubsub.SubscriptionOptions{
@@ -571,6 +620,12 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 		case change := <-subPhysicalIOAdapter.MsgChan():
 			subPhysicalIOAdapter.ProcessChange(change)
 
+		case event := <-hyper.DomainEvents():
+			dispatchDomainEvent(&domainCtx, event)
+
+		case <-usbChanges:
+			rescanUsbDevices(&domainCtx)
+
 		case <-publishTimer.C:
 			start := time.Now()
 			err = cipherMetricsPub.Publish("global", cipher.GetCipherMetrics())
@@ -678,8 +733,15 @@ type handlers map[string]chan<- Notify
 
 var handlerMap handlers
 
+// Events reported by the hypervisor are passed on to the same goroutine
+// on a separate channel with the same key
+type eventHandlers map[string]chan<- types.DomainEvent
+
+var eventHandlerMap eventHandlers
+
 func handlersInit() {
 	handlerMap = make(handlers)
+	eventHandlerMap = make(eventHandlers)
 }
 
 // Wrappers around handleCreate, handleModify, and handleDelete
@@ -714,8 +776,10 @@ func handleDomainCreate(ctxArg interface{}, key string, configArg interface{}) {
 	}
 	h1 := make(chan Notify, 1)
 	handlerMap[config.Key()] = h1
+	e1 := make(chan types.DomainEvent, 8)
+	eventHandlerMap[config.Key()] = e1
 	log.Functionf("Creating %s at %s", "runHandler", agentlog.GetMyStack())
-	go runHandler(ctx, key, h1)
+	go runHandler(ctx, key, h1, e1)
 	h = h1
 	select {
 	case h <- Notify{}:
@@ -743,6 +807,7 @@ func handleDomainDelete(ctxArg interface{}, key string,
 		log.Functionf("Closing channel")
 		close(h)
 		delete(handlerMap, key)
+		delete(eventHandlerMap, key)
 	} else {
 		log.Tracef("handleDomainDelete: unknown %s", key)
 		return
@@ -751,8 +816,10 @@ func handleDomainDelete(ctxArg interface{}, key string,
 }
 
 // Server for each domU
-// Runs timer every 30 seconds to update status
-func runHandler(ctx *domainContext, key string, c <-chan Notify) {
+// Runs timer every 30 seconds to update status, and a slower one to ask
+// the guest agent, if any, about the guest
+func runHandler(ctx *domainContext, key string, c <-chan Notify,
+	events <-chan types.DomainEvent) {
 
 	log.Functionf("runHandler starting")
 
@@ -762,6 +829,10 @@ func runHandler(ctx *domainContext, key string, c <-chan Notify) {
 	ticker := flextimer.NewRangeTicker(time.Duration(min),
 		time.Duration(max))
 
+	guestInfoMax := float64(guestInfoInterval)
+	guestInfoTicker := flextimer.NewRangeTicker(
+		time.Duration(guestInfoMax*0.3), time.Duration(guestInfoMax))
+
 	closed := false
 	for !closed {
 		select {
@@ -788,6 +859,11 @@ func runHandler(ctx *domainContext, key string, c <-chan Notify) {
 				}
 				closed = true
 			}
+		case event := <-events:
+			status := lookupDomainStatus(ctx, key)
+			if status != nil {
+				handleDomainEvent(ctx, status, event)
+			}
 		case <-ticker.C:
 			log.Tracef("runHandler(%s) timer", key)
 			status := lookupDomainStatus(ctx, key)
@@ -795,11 +871,73 @@ func runHandler(ctx *domainContext, key string, c <-chan Notify) {
 				verifyStatus(ctx, status)
 				maybeRetry(ctx, status)
 			}
+		case <-guestInfoTicker.C:
+			status := lookupDomainStatus(ctx, key)
+			if status != nil && status.EnableGuestAgent && status.Activated {
+				updateGuestInfo(ctx, status)
+			}
 		}
 	}
+	ticker.StopTicker()
+	guestInfoTicker.StopTicker()
 	log.Functionf("runHandler(%s) DONE", key)
 }
 
+// dispatchDomainEvent passes an event from the hypervisor on to the
+// goroutine of the domain
+func dispatchDomainEvent(ctx *domainContext, event types.DomainEvent) {
+
+	log.Functionf("dispatchDomainEvent(%s) %s", event.DomainName,
+		event.Type)
+	for key, c := range ctx.subDomainConfig.GetAll() {
+		config := c.(types.DomainConfig)
+		if config.GetTaskName() != event.DomainName {
+			continue
+		}
+		e, ok := eventHandlerMap[key]
+		if !ok {
+			break
+		}
+		select {
+		case e <- event:
+		default:
+			// handler is slow; verifyStatus will catch up
+			log.Warnf("dispatchDomainEvent(%s) NOT sent %s. Slow handler?",
+				key, event.Type)
+		}
+		return
+	}
+	log.Warnf("dispatchDomainEvent: no handler for %s event %s",
+		event.DomainName, event.Type)
+}
+
+// handleDomainEvent updates the status right away instead of waiting for
+// verifyStatus to notice on the next timer
+func handleDomainEvent(ctx *domainContext, status *types.DomainStatus,
+	event types.DomainEvent) {
+
+	log.Noticef("handleDomainEvent(%s) %s at %s: %s", status.Key(),
+		event.Type, event.Time.Format(time.RFC3339Nano), event.Details)
+	switch event.Type {
+	case types.DomainEventGuestPanicked:
+		status.SetError("application's guest kernel panicked - please restart application instance", time.Now())
+		publishDomainStatus(ctx, status)
+	case types.DomainEventBlockIOError:
+		// The error source lets us clear it once the domain resumes
+		status.SetErrorWithSource(fmt.Sprintf("I/O error on a disk of the application: %s",
+			event.Details), types.DomainEvent{}, event.Time)
+		publishDomainStatus(ctx, status)
+	case types.DomainEventResume:
+		if status.IsErrorSource(types.DomainEvent{}) {
+			log.Noticef("handleDomainEvent(%s) clearing %s",
+				status.Key(), status.Error)
+			status.ClearErrorWithSource()
+			publishDomainStatus(ctx, status)
+		}
+	}
+	verifyStatus(ctx, status)
+}
+
 // Check if it is still running
 func verifyStatus(ctx *domainContext, status *types.DomainStatus) {
 	// Check config.Active to avoid spurious errors when shutting down
@@ -817,15 +955,26 @@ func verifyStatus(ctx *domainContext, status *types.DomainStatus) {
 			log.Warnln(errStr)
 			status.Activated = false
 			status.State = types.HALTED
+			stopConsole(ctx, status)
+
+			reason := "halted"
+			if err != nil {
+				reason = err.Error()
+			}
+			restarting := handleDomainExit(ctx, config, status, reason,
+				err != nil || domainStatus == types.BROKEN)
 
 			// check if task is in the BROKEN state and kill it (later on we may do some
 			// level of recovery or at least gather some intel on why and how it crashed)
 			// NOTE: we don't do anything for repairing tasks in the UNKNOWN state, for those
 			// the only remedy is an explicit user action (delete, restart, etc.)
+			// unless the restart policy has us boot it again
 			if domainStatus == types.BROKEN {
 				err := fmt.Errorf("one of the %s tasks has crashed (%v)", status.Key(), err)
 				log.Errorf(err.Error())
-				status.SetErrorNow("one of the application's tasks has crashed - please restart application instance")
+				if !restarting {
+					status.SetError("one of the application's tasks has crashed - please restart application instance", time.Now())
+				}
 				if err := hyper.Task(status).Delete(status.DomainName, status.DomainId); err != nil {
 					log.Errorf("failed to delete domain: %s (%v)", status.DomainName, err)
 				}
@@ -841,7 +990,7 @@ func verifyStatus(ctx *domainContext, status *types.DomainStatus) {
 			if status.HasError() {
 				log.Noticef("verifyDomain(%s) clearing existing error: %s",
 					status.Key(), status.Error)
-				status.ClearError()
+				status.ClearErrorWithSource()
 			}
 			status.DomainId = domainID
 			status.BootTime = time.Now()
@@ -862,13 +1011,68 @@ func verifyStatus(ctx *domainContext, status *types.DomainStatus) {
 				status.Key())
 			publishDomainStatus(ctx, status)
 		}
+		if status.IsPod() && status.Activated {
+			updatePodContainers(ctx, status)
+		}
+		maybeClearCrashLoop(ctx, status)
+	}
+}
+
+// updateGuestInfo asks the guest agent, if any, how the guest is doing
+func updateGuestInfo(ctx *domainContext, status *types.DomainStatus) {
+	info, err := hyper.Task(status).GuestInfo(status.DomainName, status.DomainId)
+	if info == nil {
+		return
+	}
+	if err != nil {
+		// Could be the guest is still booting or doesn't run the agent
+		if status.GuestInfo.Responsive {
+			log.Warnf("updateGuestInfo(%s) guest agent stopped responding: %s",
+				status.Key(), err)
+		}
+		// Keep what we learnt last time the agent answered
+		lastInfo := status.GuestInfo
+		lastInfo.Responsive = false
+		info = &lastInfo
+	} else if !status.GuestInfo.Responsive {
+		log.Noticef("updateGuestInfo(%s) guest agent is responding; %s",
+			status.Key(), info.OSName)
+	}
+	// Only publish on changes other than LastSeen
+	old := status.GuestInfo
+	old.LastSeen = info.LastSeen
+	status.GuestInfo = *info
+	if !cmp.Equal(old, *info) {
+		publishDomainStatus(ctx, status)
+	}
+}
+
+// updatePodContainers picks up the status of the additional containers of
+// a pod; the main one is what the domain state reflects
+func updatePodContainers(ctx *domainContext, status *types.DomainStatus) {
+	pcs, err := hyper.Task(status).PodContainers(status.DomainName)
+	if err != nil {
+		log.Errorf("updatePodContainers(%s) failed: %s", status.Key(), err)
+		return
+	}
+	if cmp.Equal(status.PodContainers, pcs) {
+		return
+	}
+	for _, pc := range pcs {
+		if pc.State == types.BROKEN {
+			log.Warnf("updatePodContainers(%s) container %s exited with %d",
+				status.Key(), pc.Name, pc.ExitCode)
+		}
 	}
+	status.PodContainers = pcs
+	publishDomainStatus(ctx, status)
 }
 
 func maybeRetry(ctx *domainContext, status *types.DomainStatus) {
 
 	maybeRetryBoot(ctx, status)
 	maybeRetryAdapters(ctx, status)
+	maybeRestart(ctx, status)
 }
 
 // Retry a boot after a failure.
@@ -899,13 +1103,9 @@ func maybeRetryBoot(ctx *domainContext, status *types.DomainStatus) {
 		return
 	}
 
-	t := time.Now()
-	elapsed := t.Sub(status.ErrorTime)
-	timeLimit := time.Duration(ctx.domainBootRetryTime) * time.Second
-	if elapsed < timeLimit {
+	if remaining := time.Until(status.NextRestartTime); remaining > 0 {
 		log.Functionf("maybeRetryBoot(%s) %d remaining",
-			status.Key(),
-			(timeLimit-elapsed)/time.Second)
+			status.Key(), remaining/time.Second)
 		return
 	}
 	log.Noticef("maybeRetryBoot(%s) after %s at %v",
@@ -914,7 +1114,7 @@ func maybeRetryBoot(ctx *domainContext, status *types.DomainStatus) {
 	if status.HasError() {
 		log.Noticef("maybeRetryBoot(%s) clearing existing error: %s",
 			status.Key(), status.Error)
-		status.ClearError()
+		status.ClearErrorWithSource()
 	}
 	status.TriedCount += 1
 
@@ -924,8 +1124,8 @@ func maybeRetryBoot(ctx *domainContext, status *types.DomainStatus) {
 	if err != nil {
 		log.Errorf("maybeRetryBoot DomainCreate for %s: %s",
 			status.DomainName, err)
-		status.BootFailed = true
-		status.SetErrorNow(err.Error())
+		scheduleBootRetry(ctx, status)
+		status.SetError(err.Error(), time.Now())
 		publishDomainStatus(ctx, status)
 		return
 	}
@@ -963,7 +1163,7 @@ func maybeRetryAdapters(ctx *domainContext, status *types.DomainStatus) {
 		log.Errorf("Failed to reserve adapters for %s: %s",
 			config.Key(), err)
 		status.PendingAdd = false
-		status.SetErrorNow(err.Error())
+		status.SetError(err.Error(), time.Now())
 		status.AdaptersFailed = true
 		publishDomainStatus(ctx, status)
 		cleanupAdapters(ctx, config.IoAdapterList,
@@ -974,7 +1174,7 @@ func maybeRetryAdapters(ctx *domainContext, status *types.DomainStatus) {
 	if status.HasError() {
 		log.Noticef("maybeRetryAdapters(%s) clearing existing error: %s",
 			status.Key(), status.Error)
-		status.ClearError()
+		status.ClearErrorWithSource()
 	}
 
 	// We now have reserved all of the IoAdapters
@@ -1035,6 +1235,9 @@ func handleCreate(ctx *domainContext, key string, config *types.DomainConfig) {
 		VncDisplay:         config.VncDisplay,
 		VncPasswd:          config.VncPasswd,
 		State:              types.INSTALLED,
+		NUMANode:           -1,
+		// Only act on snapshot commands issued after the create
+		SnapshotCounter: config.SnapshotCmd.Counter,
 	}
 	// Note that the -emu interface doesn't exist until after boot of the domU, but we
 	// initialize the VifList here with the VifUsed.
@@ -1049,7 +1252,7 @@ func handleCreate(ctx *domainContext, key string, config *types.DomainConfig) {
 		log.Errorf("Failed to create DomainStatus from %v: %s",
 			config, err)
 		status.PendingAdd = false
-		status.SetErrorNow(err.Error())
+		status.SetError(err.Error(), time.Now())
 		publishDomainStatus(ctx, &status)
 		return
 	}
@@ -1058,7 +1261,7 @@ func handleCreate(ctx *domainContext, key string, config *types.DomainConfig) {
 		log.Errorf("Failed to reserve adapters for %v: %s",
 			config, err)
 		status.PendingAdd = false
-		status.SetErrorNow(err.Error())
+		status.SetError(err.Error(), time.Now())
 		status.AdaptersFailed = true
 		publishDomainStatus(ctx, &status)
 		cleanupAdapters(ctx, config.IoAdapterList,
@@ -1200,7 +1403,7 @@ func doActivate(ctx *domainContext, config types.DomainConfig,
 			log.Errorf("Failed to reserve adapters for %s: %s",
 				config.Key(), err)
 			status.PendingAdd = false
-			status.SetErrorNow(err.Error())
+			status.SetError(err.Error(), time.Now())
 			status.AdaptersFailed = true
 			publishDomainStatus(ctx, status)
 			cleanupAdapters(ctx, config.IoAdapterList,
@@ -1218,7 +1421,7 @@ func doActivate(ctx *domainContext, config types.DomainConfig,
 		log.Errorf("Failed to assign adapters for %s: %s",
 			config.Key(), err)
 		status.PendingAdd = false
-		status.SetErrorNow(err.Error())
+		status.SetError(err.Error(), time.Now())
 		status.AdaptersFailed = true
 		publishDomainStatus(ctx, status)
 		cleanupAdapters(ctx, config.IoAdapterList, config.UUIDandVersion.UUID)
@@ -1236,7 +1439,7 @@ func doActivate(ctx *domainContext, config types.DomainConfig,
 				err := fmt.Errorf("doActivate: Failed mount snapshot: %s for %s. Error %s",
 					snapshotID, config.UUIDandVersion.UUID, err)
 				log.Error(err.Error())
-				status.SetErrorNow(err.Error())
+				status.SetError(err.Error(), time.Now())
 				return
 			}
 		default:
@@ -1249,12 +1452,30 @@ func doActivate(ctx *domainContext, config types.DomainConfig,
 			}
 			if err != nil {
 				log.Errorf("Failed to check disk format: %v", err.Error())
-				status.SetErrorNow(err.Error())
+				status.SetError(err.Error(), time.Now())
 				return
 			}
 		}
 	}
 
+	status.EnableGuestAgent = config.EnableGuestAgent
+	status.GuestInfo = types.GuestInfo{}
+	status.SecurityProfile = nil
+	status.PodContainers = nil
+
+	if err := assignCPUs(ctx, &config, status); err != nil {
+		log.Errorf("Failed to assign CPUs for %s: %s",
+			config.Key(), err)
+		status.SetError(err.Error(), time.Now())
+		return
+	}
+	if err := provisionVTPM(hyper.Name(), config, status); err != nil {
+		log.Errorf("Failed to provision the TPM of %s: %s",
+			config.Key(), err)
+		status.SetError(err.Error(), time.Now())
+		return
+	}
+
 	filename := xenCfgFilename(config.AppNum)
 	file, err := os.Create(filename)
 	if err != nil {
@@ -1265,9 +1486,15 @@ func doActivate(ctx *domainContext, config types.DomainConfig,
 	if err := hyper.Task(status).Setup(*status, config, ctx.assignableAdapters, file); err != nil {
 		log.Errorf("Failed to create DomainStatus from %v: %s",
 			config, err)
-		status.SetErrorNow(err.Error())
+		status.SetError(err.Error(), time.Now())
 		return
 	}
+	if profile, err := hyper.Task(status).SecurityProfile(status.DomainName); err != nil {
+		log.Warnf("Failed to get the security profile of %s: %s",
+			status.DomainName, err)
+	} else {
+		status.SecurityProfile = profile
+	}
 
 	status.TriedCount = 0
 	var domainID int
@@ -1283,8 +1510,8 @@ func doActivate(ctx *domainContext, config types.DomainConfig,
 		}
 		if status.TriedCount >= 3 {
 			log.Errorf("DomainCreate for %s: %s", status.DomainName, err)
-			status.BootFailed = true
-			status.SetErrorNow(err.Error())
+			scheduleBootRetry(ctx, status)
+			status.SetError(err.Error(), time.Now())
 			publishDomainStatus(ctx, status)
 			return
 		}
@@ -1312,7 +1539,7 @@ func doActivateTail(ctx *domainContext, status *types.DomainStatus,
 	err := hyper.Task(status).Start(status.DomainName, domainID)
 	if err != nil {
 		log.Errorf("domain start for %s: %s", status.DomainName, err)
-		status.SetErrorNow(err.Error())
+		status.SetError(err.Error(), time.Now())
 
 		// Cleanup
 		if err := hyper.Task(status).Delete(status.DomainName, status.DomainId); err != nil {
@@ -1320,7 +1547,7 @@ func doActivateTail(ctx *domainContext, status *types.DomainStatus,
 		}
 
 		// Set BootFailed to cause retry
-		status.BootFailed = true
+		scheduleBootRetry(ctx, status)
 		status.State = types.BROKEN
 		return
 	}
@@ -1333,10 +1560,10 @@ func doActivateTail(ctx *domainContext, status *types.DomainStatus,
 
 	if err != nil {
 		// Immediate failure treat as above
-		status.BootFailed = true
+		scheduleBootRetry(ctx, status)
 		status.State = state
 		status.Activated = false
-		status.SetErrorNow(err.Error())
+		status.SetError(err.Error(), time.Now())
 		log.Errorf("doActivateTail(%v) failed for %s: %s",
 			status.UUIDandVersion, status.DisplayName, err)
 		// Cleanup
@@ -1353,15 +1580,65 @@ func doActivateTail(ctx *domainContext, status *types.DomainStatus,
 			status.Key())
 	}
 	status.Activated = true
+	startConsole(ctx, status)
 	log.Functionf("doActivateTail(%v) done for %s",
 		status.UUIDandVersion, status.DisplayName)
 }
 
+// assignCPUs pins the domain to the CPUs in its config, or if there are none
+// and CPU pinning is enabled to CPUs dedicated to it. Updates config.CPUs
+// which is what the hypervisor uses.
+func assignCPUs(ctx *domainContext, config *types.DomainConfig,
+	status *types.DomainStatus) error {
+
+	node := -1
+	dedicated := false
+	if config.CPUs != "" {
+		cpus, err := parseCPUList(config.CPUs)
+		if err != nil {
+			return err
+		}
+		if node, err = ctx.cpuAllocator.reserve(config.Key(), cpus); err != nil {
+			return err
+		}
+		config.CPUs = formatCPUList(cpus)
+	} else if ctx.pinCPUs {
+		count := config.VCpus
+		if count == 0 {
+			count = 1
+		}
+		cpus, n, err := ctx.cpuAllocator.allocate(config.Key(), count)
+		if err != nil {
+			return err
+		}
+		node = n
+		dedicated = true
+		config.CPUs = formatCPUList(cpus)
+	} else {
+		ctx.cpuAllocator.release(config.Key())
+	}
+	status.CPUs = config.CPUs
+	status.CPUsDedicated = dedicated
+	status.NUMANode = node
+	log.Functionf("assignCPUs(%s) CPUs %s dedicated %t NUMA node %d",
+		config.Key(), status.CPUs, status.CPUsDedicated, status.NUMANode)
+	return nil
+}
+
+// releaseCPUs lets other domains use the CPUs of the domain
+func releaseCPUs(ctx *domainContext, status *types.DomainStatus) {
+	ctx.cpuAllocator.release(status.Key())
+	status.CPUs = ""
+	status.CPUsDedicated = false
+	status.NUMANode = -1
+}
+
 // shutdown and wait for the domain to go away; if that fails destroy and wait
 func doInactivate(ctx *domainContext, status *types.DomainStatus, impatient bool) {
 
 	log.Functionf("doInactivate(%v) for %s domainId %d",
 		status.UUIDandVersion, status.DisplayName, status.DomainId)
+	stopConsole(ctx, status)
 	domainID, _, err := hyper.Task(status).Info(status.DomainName, status.DomainId)
 	if err == nil && domainID != status.DomainId {
 		status.DomainId = domainID
@@ -1458,11 +1735,19 @@ func doInactivate(ctx *domainContext, status *types.DomainStatus, impatient bool
 		log.Error(errStr)
 		// Don't clobber an existing error
 		if !status.HasError() {
-			status.SetErrorNow(errStr)
+			status.SetError(errStr, time.Now())
 		}
 	} else {
 		status.Activated = false
 		status.State = types.HALTED
+		releaseCPUs(ctx, status)
+		// Unlike the snapshots of VMs which are kept in the volumes
+		// the checkpoints of a container are deleted with its task
+		if isContainerTask(status) && len(status.Snapshots) != 0 {
+			log.Noticef("doInactivate(%v) dropping snapshots %v for %s",
+				status.UUIDandVersion, status.Snapshots, status.DisplayName)
+			status.Snapshots = nil
+		}
 	}
 	publishDomainStatus(ctx, status)
 
@@ -1472,6 +1757,12 @@ func doInactivate(ctx *domainContext, status *types.DomainStatus, impatient bool
 		status.UUIDandVersion, status.DisplayName)
 }
 
+// isContainerTask returns true if the domain runs as a containerd task
+func isContainerTask(status *types.DomainStatus) bool {
+	task, ok := hyper.Task(status).(hypervisor.Hypervisor)
+	return ok && task.Name() == "containerd"
+}
+
 // XXX currently only unassigns USB if usbAccess is set
 func pciUnassign(ctx *domainContext, status *types.DomainStatus,
 	ignoreErrors bool) {
@@ -1522,7 +1813,7 @@ func pciUnassign(ctx *domainContext, status *types.DomainStatus,
 	for _, long := range assignments {
 		err := hyper.PCIRelease(long)
 		if err != nil && !ignoreErrors {
-			status.SetErrorNow(err.Error())
+			status.SetError(err.Error(), time.Now())
 		}
 	}
 	ctx.publishAssignableAdapters()
@@ -1534,6 +1825,20 @@ func configToStatus(ctx *domainContext, config types.DomainConfig,
 
 	log.Functionf("configToStatus(%v) for %s",
 		config.UUIDandVersion, config.DisplayName)
+	// The snapshots are kept with the volumes hence are gone once the
+	// volumes are replaced, e.g., by a purge
+	if len(status.Snapshots) != 0 {
+		sameVolumes := len(status.DiskStatusList) == len(config.DiskConfigList)
+		for i := 0; sameVolumes && i < len(config.DiskConfigList); i++ {
+			sameVolumes = status.DiskStatusList[i].VolumeKey ==
+				config.DiskConfigList[i].VolumeKey
+		}
+		if !sameVolumes {
+			log.Noticef("configToStatus(%v) dropping snapshots %v for %s",
+				config.UUIDandVersion, status.Snapshots, config.DisplayName)
+			status.Snapshots = nil
+		}
+	}
 	status.DiskStatusList = make([]types.DiskStatus,
 		len(config.DiskConfigList))
 	need9P := false
@@ -1545,6 +1850,7 @@ func configToStatus(ctx *domainContext, config types.DomainConfig,
 		ds.Format = dc.Format
 		ds.MountDir = dc.MountDir
 		ds.DisplayName = dc.DisplayName
+		ds.PodContainer = dc.PodContainer
 		// Generate Devtype for hypervisor package
 		// XXX can hypervisor look at something different?
 		if dc.Format == zconfig.Format_CONTAINER {
@@ -1704,7 +2010,7 @@ func handleModify(ctx *domainContext, key string,
 			log.Errorf("Failed to update DomainStatus from %v: %s",
 				config, err)
 			status.PendingModify = false
-			status.SetErrorNow(err.Error())
+			status.SetError(err.Error(), time.Now())
 			publishDomainStatus(ctx, status)
 			return
 		}
@@ -1717,7 +2023,7 @@ func handleModify(ctx *domainContext, key string,
 		if status.HasError() {
 			log.Noticef("handleModify(%s) clearing existing error: %s",
 				status.Key(), status.Error)
-			status.ClearError()
+			status.ClearErrorWithSource()
 			publishDomainStatus(ctx, status)
 			doInactivate(ctx, status, false)
 			updateStatusFromConfig(status, *config)
@@ -1732,12 +2038,20 @@ func handleModify(ctx *domainContext, key string,
 			log.Errorf("Failed to update DomainStatus from %v: %s",
 				config, err)
 			status.PendingModify = false
-			status.SetErrorNow(err.Error())
+			status.SetError(err.Error(), time.Now())
 			publishDomainStatus(ctx, status)
 			return
 		}
 		updateStatusFromConfig(status, *config)
 		changed = true
+	} else if status.Activated && status.State == types.RUNNING {
+		if hotplugDevices(ctx, config, status) {
+			changed = true
+		}
+	}
+	if config.SnapshotCmd.Counter != status.SnapshotCounter {
+		handleSnapshotCmd(ctx, config, status)
+		changed = true
 	}
 	if changed {
 		// XXX could we also have changes in the IoBundle?
@@ -1778,6 +2092,204 @@ func handleModify(ctx *domainContext, key string,
 		config.UUIDandVersion, config.DisplayName)
 }
 
+// hotplugDevices attaches the disks and vifs added to the config of a
+// running domain and detaches the ones removed if we attached them here.
+// Removing a disk or vif the domain was started with still takes a restart.
+// Returns true if the status changed.
+func hotplugDevices(ctx *domainContext, config *types.DomainConfig,
+	status *types.DomainStatus) bool {
+
+	if config.VirtualizationMode == types.LEGACY {
+		// Emulated devices can't be hot-plugged
+		return false
+	}
+	changed := false
+	var errs []string
+	task := hyper.Task(status)
+
+	// Disks are matched by VolumeKey; the cloud-init ISO and the 9P
+	// share don't have one and are left alone
+	wantedDisks := make(map[string]bool)
+	for _, dc := range config.DiskConfigList {
+		wantedDisks[dc.VolumeKey] = true
+	}
+	var disks []types.DiskStatus
+	for _, ds := range status.DiskStatusList {
+		if ds.VolumeKey == "" || wantedDisks[ds.VolumeKey] || !ds.Hotplugged {
+			disks = append(disks, ds)
+			continue
+		}
+		log.Noticef("hotplugDevices(%s) detaching disk %s %s",
+			status.Key(), ds.Vdev, ds.FileLocation)
+		if err := task.DetachDisk(status.DomainName, status.DomainId, ds); err != nil {
+			errs = append(errs, err.Error())
+			disks = append(disks, ds)
+			continue
+		}
+		changed = true
+	}
+	haveDisks := make(map[string]bool)
+	usedVdevs := make(map[string]bool)
+	for _, ds := range disks {
+		haveDisks[ds.VolumeKey] = true
+		usedVdevs[ds.Vdev] = true
+	}
+	for _, dc := range config.DiskConfigList {
+		if haveDisks[dc.VolumeKey] {
+			continue
+		}
+		if dc.PodContainer != "" {
+			errs = append(errs, fmt.Sprintf("can't add container %s to the running pod",
+				dc.PodContainer))
+			continue
+		}
+		vdev := freeVdev(usedVdevs)
+		if vdev == "" {
+			errs = append(errs, fmt.Sprintf("no vdev left to hot-plug %s", dc.DisplayName))
+			continue
+		}
+		ds := types.DiskStatus{
+			VolumeKey:    dc.VolumeKey,
+			ReadOnly:     dc.ReadOnly,
+			FileLocation: dc.FileLocation,
+			Format:       dc.Format,
+			MountDir:     dc.MountDir,
+			DisplayName:  dc.DisplayName,
+			Devtype:      "hdd",
+			Vdev:         vdev,
+			Hotplugged:   true,
+		}
+		if dc.Format == zconfig.Format_CONTAINER {
+			ds.Devtype = ""
+		}
+		log.Noticef("hotplugDevices(%s) attaching disk %s %s",
+			status.Key(), ds.Vdev, ds.FileLocation)
+		if err := task.AttachDisk(status.DomainName, status.DomainId, ds); err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		usedVdevs[vdev] = true
+		disks = append(disks, ds)
+		changed = true
+	}
+	status.DiskStatusList = disks
+
+	// Vifs are matched by name
+	wantedVifs := make(map[string]bool)
+	for _, vif := range config.VifList {
+		wantedVifs[vif.Vif] = true
+	}
+	var vifs []types.VifInfo
+	for _, vif := range status.VifList {
+		if wantedVifs[vif.Vif] || !vif.Hotplugged {
+			vifs = append(vifs, vif)
+			continue
+		}
+		log.Noticef("hotplugDevices(%s) detaching vif %s", status.Key(), vif.Vif)
+		if err := task.DetachVif(status.DomainName, status.DomainId, vif); err != nil {
+			errs = append(errs, err.Error())
+			vifs = append(vifs, vif)
+			continue
+		}
+		changed = true
+	}
+	haveVifs := make(map[string]bool)
+	for _, vif := range vifs {
+		haveVifs[vif.Vif] = true
+	}
+	for _, vif := range checkIfEmu(config.VifList) {
+		if haveVifs[vif.Vif] {
+			continue
+		}
+		vif.Hotplugged = true
+		log.Noticef("hotplugDevices(%s) attaching vif %s", status.Key(), vif.Vif)
+		if err := task.AttachVif(status.DomainName, status.DomainId, vif); err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		vifs = append(vifs, vif)
+		changed = true
+	}
+	status.VifList = vifs
+
+	if len(errs) != 0 {
+		log.Errorf("hotplugDevices(%v) failed for %s: %s",
+			config.UUIDandVersion, config.DisplayName, strings.Join(errs, "; "))
+		status.SetError(strings.Join(errs, "; "), time.Now())
+		changed = true
+	}
+	return changed
+}
+
+// freeVdev returns the first xvd[x] not in use; xvdz is kept for cloud-init
+func freeVdev(used map[string]bool) string {
+	for c := 'a'; c < 'z'; c++ {
+		vdev := fmt.Sprintf("xvd%c", c)
+		if !used[vdev] {
+			return vdev
+		}
+	}
+	return ""
+}
+
+// handleSnapshotCmd applies the DomainSnapshotCmd from the config to a
+// running domain. The counter is consumed even if this fails so that we do
+// not retry a failing snapshot or restore on every modify.
+func handleSnapshotCmd(ctx *domainContext, config *types.DomainConfig,
+	status *types.DomainStatus) {
+
+	cmd := config.SnapshotCmd
+	log.Functionf("handleSnapshotCmd(%v) %s %s counter %d for %s",
+		config.UUIDandVersion, cmd.Action, cmd.Name, cmd.Counter,
+		config.DisplayName)
+	status.SnapshotCounter = cmd.Counter
+
+	err := cmd.Validate()
+	switch {
+	case err != nil:
+	case !status.Activated:
+		err = fmt.Errorf("snapshot %s of %s: domain is not active",
+			cmd.Action, config.DisplayName)
+	case cmd.Action == types.SnapshotActionSave:
+		err = hyper.Task(status).Snapshot(status.DomainName, status.DomainId, cmd.Name)
+		if err == nil && !status.HasSnapshot(cmd.Name) {
+			status.Snapshots = append(status.Snapshots, cmd.Name)
+		}
+	case cmd.Action == types.SnapshotActionRestore:
+		if !status.HasSnapshot(cmd.Name) {
+			err = fmt.Errorf("no snapshot %s for %s",
+				cmd.Name, config.DisplayName)
+			break
+		}
+		err = hyper.Task(status).Restore(status.DomainName, status.DomainId, cmd.Name)
+		if err == nil {
+			// Pick up any new domainID; e.g., a restored container task
+			domainID, state, infoErr := hyper.Task(status).Info(status.DomainName, status.DomainId)
+			if infoErr == nil {
+				if domainID != status.DomainId {
+					status.DomainId = domainID
+					status.BootTime = time.Now()
+					log.Noticef("Update domainId %d bootTime %s for %s",
+						status.DomainId, status.BootTime.Format(time.RFC3339Nano),
+						status.Key())
+				}
+				status.State = state
+			}
+		}
+	}
+	if err != nil {
+		log.Errorf("handleSnapshotCmd(%v) failed for %s: %s",
+			config.UUIDandVersion, config.DisplayName, err)
+		status.SetError(err.Error(), time.Now())
+		return
+	}
+	if status.HasError() {
+		status.ClearErrorWithSource()
+	}
+	log.Functionf("handleSnapshotCmd(%v) done for %s",
+		config.UUIDandVersion, config.DisplayName)
+}
+
 func updateStatusFromConfig(status *types.DomainStatus, config types.DomainConfig) {
 	status.VirtualizationMode = config.VirtualizationModeOrDefault()
 	status.EnableVnc = config.EnableVnc
@@ -1858,6 +2370,8 @@ func handleDelete(ctx *domainContext, key string, status *types.DomainStatus) {
 	} else {
 		pciUnassign(ctx, status, true)
 	}
+	releaseCPUs(ctx, status)
+	removeVTPM(status.UUIDandVersion.UUID)
 
 	// Look for any adapters used by us and clear UsedByUUID
 	// XXX zedagent might assume that the setting to nil arrives before
@@ -2009,12 +2523,14 @@ func handleGlobalConfigImpl(ctxArg interface{}, key string,
 		if gcp.GlobalValueInt(types.MetricInterval) != 0 {
 			ctx.metricInterval = gcp.GlobalValueInt(types.MetricInterval)
 		}
+		ctx.pinCPUs = gcp.GlobalValueBool(types.PinAppCPUs)
 		ctx.GCInitialized = true
 	}
 	log.Functionf("handleGlobalConfigImpl done for %s. "+
-		"DomainBootRetryTime: %d, usbAccess: %t, metricInterval: %d",
+		"DomainBootRetryTime: %d, usbAccess: %t, metricInterval: %d, "+
+		"pinCPUs: %t",
 		key, ctx.domainBootRetryTime, ctx.usbAccess,
-		ctx.metricInterval)
+		ctx.metricInterval, ctx.pinCPUs)
 }
 
 func handleGlobalConfigDelete(ctxArg interface{}, key string,
@@ -2305,6 +2821,8 @@ func handlePhysicalIOAdapterListImpl(ctxArg interface{}, key string,
 				"- No Change", phyAdapter.Phylabel)
 		}
 	}
+	// the USB rules might have changed
+	refreshUsbBundles(ctx)
 	ctx.publishAssignableAdapters()
 	log.Functionf("handlePhysicalIOAdapterListImpl() done len %d",
 		len(aa.IoBundleList))
@@ -102,3 +102,16 @@ func decodeAndParseEnvVariablesFromCloudInit(ciStr string) (map[string]string, e
 
 	return parseEnvVariablesFromCloudInit(string(ud))
 }
+
+func TestFreeVdev(t *testing.T) {
+	used := map[string]bool{"xvda": true, "xvdb": true, "xvdd": true, "xvdz": true}
+	if vdev := freeVdev(used); vdev != "xvdc" {
+		t.Errorf("freeVdev returned %s; expected xvdc", vdev)
+	}
+	for c := 'a'; c < 'z'; c++ {
+		used[fmt.Sprintf("xvd%c", c)] = true
+	}
+	if vdev := freeVdev(used); vdev != "" {
+		t.Errorf("freeVdev returned %s with all vdevs in use", vdev)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/lf-edge/eve/pkg/pillar/hypervisor"
 	"time"
 
+	"github.com/lf-edge/eve/pkg/pillar/containerd"
 	"github.com/lf-edge/eve/pkg/pillar/flextimer"
 	"github.com/lf-edge/eve/pkg/pillar/types"
 	"github.com/shirou/gopsutil/cpu"
@@ -60,6 +61,77 @@ func getAndPublishMetrics(ctx *domainContext, hyper hypervisor.Hypervisor) {
 		formatAndPublishHostCPUMem(ctx, hm)
 	}
 	ctx.pubHostMemory.Publish("global", hm)
+
+	adjustBalloons(ctx, hyper, hm)
+	publishContainerMetrics(ctx)
+}
+
+// publishContainerMetrics publishes the usage of the cgroup resources by the
+// containerd tasks of container domains
+func publishContainerMetrics(ctx *domainContext) {
+	if ctx.ctrdClient == nil {
+		client, err := containerd.NewContainerdClient()
+		if err != nil {
+			log.Errorf("publishContainerMetrics: %v", err)
+			return
+		}
+		ctx.ctrdClient = client
+	}
+	ctrdCtx, done := ctx.ctrdClient.CtrNewUserServicesCtx()
+	defer done()
+
+	var systemCPUTotal uint64
+	if cpuStat, err := cpu.Times(false); err == nil {
+		for _, t := range cpuStat {
+			systemCPUTotal += uint64(t.Total())
+		}
+	}
+	now := time.Now()
+	seen := make(map[string]bool)
+	for _, st := range ctx.pubDomainStatus.GetAll() {
+		status := st.(types.DomainStatus)
+		if status.VirtualizationMode != types.NOHYPER || !status.Activated ||
+			status.State != types.RUNNING {
+			continue
+		}
+		stats, err := ctx.ctrdClient.CtrGetContainerStats(ctrdCtx, status.DomainName)
+		if err != nil {
+			log.Errorf("publishContainerMetrics(%s): %v", status.DomainName, err)
+			continue
+		}
+		stats.ContainerName = status.DisplayName
+		stats.Uptime = status.BootTime.UnixNano()
+		stats.SystemCPUTotal = systemCPUTotal
+		acMetrics := types.AppContainerMetrics{
+			UUIDandVersion: status.UUIDandVersion,
+			CollectTime:    now,
+			StatsList:      []types.AppContainerStats{stats},
+		}
+		// the usage of the main container of a pod includes the others
+		// since their cgroups are nested under its one
+		for _, pc := range status.PodContainers {
+			if pc.State != types.RUNNING {
+				continue
+			}
+			id := types.PodContainerTaskName(status.DomainName, pc.Name)
+			stats, err := ctx.ctrdClient.CtrGetContainerStats(ctrdCtx, id)
+			if err != nil {
+				log.Errorf("publishContainerMetrics(%s): %v", id, err)
+				continue
+			}
+			stats.ContainerName = pc.Name
+			stats.Uptime = status.BootTime.UnixNano()
+			stats.SystemCPUTotal = systemCPUTotal
+			acMetrics.StatsList = append(acMetrics.StatsList, stats)
+		}
+		seen[acMetrics.Key()] = true
+		ctx.pubAppContainerMetrics.Publish(acMetrics.Key(), acMetrics)
+	}
+	for key := range ctx.pubAppContainerMetrics.GetAll() {
+		if !seen[key] {
+			ctx.pubAppContainerMetrics.Unpublish(key)
+		}
+	}
 }
 
 func formatAndPublishHostCPUMem(ctx *domainContext, hm types.HostMemory) {
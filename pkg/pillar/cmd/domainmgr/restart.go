@@ -0,0 +1,152 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Boot domains again after they failed to boot or stopped on their own,
+// backing off exponentially when they keep doing so
+
+package domainmgr
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+const (
+	// First wait before booting a domain again; doubles up to domainBootRetryTime
+	restartBackoffBase = 10 * time.Second
+	// A domain which ran this long before stopping starts over with the backoff
+	crashLoopResetTime = 10 * time.Minute
+	// This many exits or failed boots in a row make a crash loop
+	crashLoopThreshold = 3
+)
+
+// restartBackoff returns how long to wait after n exits or failed boots in
+// a row; restartBackoffBase doubled for each but the first, up to maxDelay
+func restartBackoff(n uint32, maxDelay time.Duration) time.Duration {
+	delay := restartBackoffBase
+	for i := uint32(1); i < n && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+	return delay
+}
+
+// noteExit accounts for an exit or a failed boot and sets when to boot
+// the domain again
+func noteExit(ctx *domainContext, status *types.DomainStatus) {
+	status.RecentExits++
+	maxDelay := time.Duration(ctx.domainBootRetryTime) * time.Second
+	status.NextRestartTime = time.Now().Add(restartBackoff(status.RecentExits, maxDelay))
+	if status.RecentExits >= crashLoopThreshold && !status.CrashLoop {
+		log.Warnf("noteExit(%s) crash loop after %d exits or failed boots in a row",
+			status.Key(), status.RecentExits)
+		status.CrashLoop = true
+	}
+}
+
+// scheduleBootRetry marks the boot as failed for maybeRetryBoot
+func scheduleBootRetry(ctx *domainContext, status *types.DomainStatus) {
+	status.BootFailed = true
+	noteExit(ctx, status)
+}
+
+// handleDomainExit is called when a running domain stopped without us
+// asking it to. Returns true if the restart policy has us boot it again.
+func handleDomainExit(ctx *domainContext, config *types.DomainConfig,
+	status *types.DomainStatus, reason string, failed bool) bool {
+
+	status.LastExitReason = reason
+	status.LastExitTime = time.Now()
+	restart := false
+	switch config.RestartPolicy {
+	case types.RestartPolicyAlways:
+		restart = true
+	case types.RestartPolicyOnFailure:
+		restart = failed
+	}
+	log.Noticef("handleDomainExit(%s) failed %t policy %s: %s",
+		status.Key(), failed, config.RestartPolicy, reason)
+	if !restart {
+		return false
+	}
+	if status.LastExitTime.Sub(status.BootTime) >= crashLoopResetTime {
+		status.RecentExits = 0
+		status.CrashLoop = false
+	}
+	noteExit(ctx, status)
+	status.RestartPending = true
+	if status.CrashLoop {
+		status.SetError(crashLoopError(status), time.Now())
+	}
+	return true
+}
+
+func crashLoopError(status *types.DomainStatus) string {
+	return fmt.Sprintf("crash loop: stopped or failed to boot %d times in a row, last: %s; next try at %s",
+		status.RecentExits, status.LastExitReason,
+		status.NextRestartTime.Format(time.RFC3339))
+}
+
+// maybeRestart boots a domain again which stopped on its own once its
+// backoff has passed
+func maybeRestart(ctx *domainContext, status *types.DomainStatus) {
+
+	if !status.RestartPending {
+		return
+	}
+	config := lookupDomainConfig(ctx, status.Key())
+	if config == nil || !config.Activate || status.Activated {
+		log.Functionf("maybeRestart(%s) no longer needed", status.Key())
+		status.RestartPending = false
+		publishDomainStatus(ctx, status)
+		return
+	}
+	if remaining := time.Until(status.NextRestartTime); remaining > 0 {
+		log.Functionf("maybeRestart(%s) %d remaining",
+			status.Key(), remaining/time.Second)
+		return
+	}
+	log.Noticef("maybeRestart(%s) restart %d after %s",
+		status.Key(), status.RestartCount+1, status.LastExitReason)
+	status.RestartPending = false
+	status.RestartCount++
+	// Clean up whatever is left of the domain and boot it from scratch
+	doInactivate(ctx, status, true)
+	if !status.CrashLoop && status.HasError() {
+		status.ClearErrorWithSource()
+	}
+	if err := configToStatus(ctx, *config, status); err != nil {
+		log.Errorf("maybeRestart(%s) failed to update DomainStatus: %s",
+			status.Key(), err)
+		status.SetError(err.Error(), time.Now())
+		publishDomainStatus(ctx, status)
+		return
+	}
+	updateStatusFromConfig(status, *config)
+	doActivate(ctx, *config, status)
+	publishDomainStatus(ctx, status)
+}
+
+// maybeClearCrashLoop starts over with the backoff once the domain has
+// been running for a while
+func maybeClearCrashLoop(ctx *domainContext, status *types.DomainStatus) {
+	if status.RecentExits == 0 || !status.Activated ||
+		status.State != types.RUNNING ||
+		time.Since(status.BootTime) < crashLoopResetTime {
+		return
+	}
+	log.Noticef("maybeClearCrashLoop(%s) running since %s after %d exits",
+		status.Key(), status.BootTime.Format(time.RFC3339), status.RecentExits)
+	status.RecentExits = 0
+	if status.CrashLoop {
+		status.CrashLoop = false
+		if status.HasError() {
+			status.ClearErrorWithSource()
+		}
+	}
+	publishDomainStatus(ctx, status)
+}
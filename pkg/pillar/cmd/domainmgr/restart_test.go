@@ -0,0 +1,87 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package domainmgr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/sirupsen/logrus"
+)
+
+func TestRestartBackoff(t *testing.T) {
+	maxDelay := 600 * time.Second
+	testMatrix := map[uint32]time.Duration{
+		0:  10 * time.Second,
+		1:  10 * time.Second,
+		2:  20 * time.Second,
+		4:  80 * time.Second,
+		7:  600 * time.Second,
+		40: 600 * time.Second,
+	}
+	for n, expected := range testMatrix {
+		if delay := restartBackoff(n, maxDelay); delay != expected {
+			t.Errorf("restartBackoff(%d) returned %v; expected %v", n, delay, expected)
+		}
+	}
+}
+
+func TestHandleDomainExit(t *testing.T) {
+	log = base.NewSourceLogObject(logrus.StandardLogger(), "domainmgr", 0)
+	ctx := &domainContext{domainBootRetryTime: 600}
+
+	testMatrix := map[string]struct {
+		policy  types.RestartPolicy
+		failed  bool
+		restart bool
+	}{
+		"never":                 {policy: types.RestartPolicyNever, failed: true, restart: false},
+		"on-failure with crash": {policy: types.RestartPolicyOnFailure, failed: true, restart: true},
+		"on-failure with halt":  {policy: types.RestartPolicyOnFailure, failed: false, restart: false},
+		"always":                {policy: types.RestartPolicyAlways, failed: false, restart: true},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		config := types.DomainConfig{RestartPolicy: test.policy}
+		status := types.DomainStatus{BootTime: time.Now()}
+		restart := handleDomainExit(ctx, &config, &status, "exit", test.failed)
+		if restart != test.restart || status.RestartPending != test.restart {
+			t.Errorf("handleDomainExit returned %t pending %t; expected %t",
+				restart, status.RestartPending, test.restart)
+		}
+		if status.LastExitReason != "exit" || status.LastExitTime.IsZero() {
+			t.Errorf("handleDomainExit didn't record the exit: %v", status)
+		}
+	}
+
+	// Quick exits in a row end up in a crash loop with growing backoff
+	config := types.DomainConfig{RestartPolicy: types.RestartPolicyAlways}
+	status := types.DomainStatus{}
+	var lastDelay time.Duration
+	for i := 1; i <= crashLoopThreshold; i++ {
+		status.BootTime = time.Now()
+		handleDomainExit(ctx, &config, &status, "exit", true)
+		delay := status.NextRestartTime.Sub(status.LastExitTime)
+		if delay <= lastDelay {
+			t.Errorf("backoff didn't grow after %d exits: %v", i, delay)
+		}
+		lastDelay = delay
+		if status.CrashLoop != (i == crashLoopThreshold) {
+			t.Errorf("crash loop %t after %d exits", status.CrashLoop, i)
+		}
+	}
+	if !status.HasError() {
+		t.Errorf("crash loop should've set an error")
+	}
+
+	// An exit after running for a while starts over
+	status.BootTime = time.Now().Add(-crashLoopResetTime)
+	handleDomainExit(ctx, &config, &status, "exit", true)
+	if status.CrashLoop || status.RecentExits != 1 {
+		t.Errorf("exit after running for %v left crash loop %t with %d exits",
+			crashLoopResetTime, status.CrashLoop, status.RecentExits)
+	}
+}
@@ -0,0 +1,236 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Track the USB devices plugged into the host. IoBundles can assign a USB
+// device by vendor:product (and serial number) instead of by UsbAddr, in
+// which case we fill in the UsbAddr of whichever matching device is plugged
+// in and hot-plug it into the running domain the bundle is assigned to.
+// Hence a replaced dongle ends up where the old one was.
+
+package domainmgr
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"golang.org/x/sys/unix"
+)
+
+const usbSysfsDevices = "/sys/bus/usb/devices"
+
+// usbDevice is a USB device plugged into the host
+type usbDevice struct {
+	addr    string // Bus:device as in IoBundle.UsbAddr
+	product string // Vendor:product
+	serial  string
+}
+
+// readUsbDevices returns the USB devices found in sysfs by their UsbAddr
+func readUsbDevices(dir string) (map[string]usbDevice, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	devices := make(map[string]usbDevice)
+	for _, entry := range entries {
+		name := entry.Name()
+		// skip the root hubs (usbN) and the interfaces (bus-port:config.interface)
+		if strings.HasPrefix(name, "usb") || strings.Contains(name, ":") {
+			continue
+		}
+		attr := func(attr string) string {
+			value, err := ioutil.ReadFile(filepath.Join(dir, name, attr))
+			if err != nil {
+				return ""
+			}
+			return strings.TrimSpace(string(value))
+		}
+		busnum, devnum := attr("busnum"), attr("devnum")
+		vendor, product := attr("idVendor"), attr("idProduct")
+		if busnum == "" || devnum == "" || vendor == "" || product == "" {
+			// gone while we looked
+			log.Functionf("readUsbDevices: skipping %s", name)
+			continue
+		}
+		dev := usbDevice{
+			addr:    busnum + ":" + devnum,
+			product: vendor + ":" + product,
+			serial:  attr("serial"),
+		}
+		devices[dev.addr] = dev
+	}
+	return devices, nil
+}
+
+// isUsbDeviceUevent checks if a kernel uevent, i.e., a header such as
+// add@/devices/... followed by NUL separated KEY=value pairs, is about a
+// USB device (as opposed to one of its interfaces)
+func isUsbDeviceUevent(msg []byte) bool {
+	var subsystem, devtype string
+	for _, field := range bytes.Split(msg, []byte{0}) {
+		kv := strings.SplitN(string(field), "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		switch kv[0] {
+		case "SUBSYSTEM":
+			subsystem = kv[1]
+		case "DEVTYPE":
+			devtype = kv[1]
+		}
+	}
+	return subsystem == "usb" && devtype == "usb_device"
+}
+
+// watchUsbUevents listens for the kernel uevents of USB devices coming and
+// going. A burst of events results in at least one notification.
+func watchUsbUevents() (<-chan struct{}, error) {
+	fd, err := unix.Socket(unix.AF_NETLINK, unix.SOCK_RAW|unix.SOCK_CLOEXEC,
+		unix.NETLINK_KOBJECT_UEVENT)
+	if err != nil {
+		return nil, err
+	}
+	// group 1 is the kernel as opposed to udev
+	if err := unix.Bind(fd, &unix.SockaddrNetlink{Family: unix.AF_NETLINK, Groups: 1}); err != nil {
+		unix.Close(fd)
+		return nil, err
+	}
+	notify := make(chan struct{}, 1)
+	go func() {
+		buf := make([]byte, 64*1024)
+		for {
+			n, _, err := unix.Recvfrom(fd, buf, 0)
+			if err != nil {
+				if err != unix.EINTR && err != unix.ENOBUFS {
+					log.Errorf("watchUsbUevents: giving up: %s", err)
+					unix.Close(fd)
+					return
+				}
+				// we may have lost some events; rescan anyway
+			} else if !isUsbDeviceUevent(buf[:n]) {
+				continue
+			}
+			select {
+			case notify <- struct{}{}:
+			default:
+			}
+		}
+	}()
+	return notify, nil
+}
+
+// rescanUsbDevices is called whenever USB devices might have come or gone
+func rescanUsbDevices(ctx *domainContext) {
+	devices, err := readUsbDevices(usbSysfsDevices)
+	if err != nil {
+		log.Errorf("rescanUsbDevices: %s", err)
+		return
+	}
+	ctx.usbDevices = devices
+	if refreshUsbBundles(ctx) {
+		ctx.publishAssignableAdapters()
+	}
+}
+
+// refreshUsbBundles applies the USB rules to the devices plugged in.
+// Returns true if the caller needs to publish the AssignableAdapters.
+func refreshUsbBundles(ctx *domainContext) bool {
+	return updateUsbBundles(ctx.assignableAdapters, ctx.usbDevices,
+		func(ib types.IoBundle, attach bool) {
+			hotplugUsb(ctx, ib, attach)
+		})
+}
+
+// updateUsbBundles fills in the UsbAddr of the IoBundles assigning USB
+// devices by rule from the devices plugged in and calls hotplug for the
+// devices which came and went. Rules with a serial number go first.
+// Returns true if any IoBundle changed.
+func updateUsbBundles(aa *types.AssignableAdapters, devices map[string]usbDevice,
+	hotplug func(ib types.IoBundle, attach bool)) bool {
+
+	changed := false
+	claimed := make(map[string]bool)
+	for _, ib := range aa.IoBundleList {
+		if ib.UsbProduct == "" && ib.UsbAddr != "" {
+			claimed[ib.UsbAddr] = true
+		}
+	}
+	for i := range aa.IoBundleList {
+		ib := &aa.IoBundleList[i]
+		if ib.UsbProduct == "" || ib.UsbAddr == "" {
+			continue
+		}
+		dev, found := devices[ib.UsbAddr]
+		if found && !claimed[dev.addr] && ib.MatchesUsbDevice(dev.product, dev.serial) {
+			claimed[dev.addr] = true
+			continue
+		}
+		log.Noticef("updateUsbBundles: %s lost USB device %s",
+			ib.Phylabel, ib.UsbAddr)
+		hotplug(*ib, false)
+		ib.UsbAddr = ""
+		changed = true
+	}
+
+	var addrs []string
+	for addr := range devices {
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+	for _, withSerial := range []bool{true, false} {
+		for i := range aa.IoBundleList {
+			ib := &aa.IoBundleList[i]
+			if ib.UsbProduct == "" || ib.UsbAddr != "" || (ib.UsbSerial != "") != withSerial {
+				continue
+			}
+			for _, addr := range addrs {
+				dev := devices[addr]
+				if claimed[addr] || !ib.MatchesUsbDevice(dev.product, dev.serial) {
+					continue
+				}
+				log.Noticef("updateUsbBundles: %s found USB device %s %s at %s",
+					ib.Phylabel, dev.product, dev.serial, addr)
+				ib.UsbAddr = addr
+				claimed[addr] = true
+				changed = true
+				hotplug(*ib, true)
+				break
+			}
+		}
+	}
+	return changed
+}
+
+// hotplugUsb attaches (or detaches) the USB device of the bundle to the
+// running domain the bundle is assigned to, if any
+func hotplugUsb(ctx *domainContext, ib types.IoBundle, attach bool) {
+	if ib.UsedByUUID == nilUUID {
+		return
+	}
+	status := lookupDomainStatus(ctx, ib.UsedByUUID.String())
+	if status == nil || !status.Activated {
+		return
+	}
+	task := hyper.Task(status)
+	if attach {
+		if err := task.AttachUsb(status.DomainName, status.DomainId, ib.UsbAddr); err != nil {
+			log.Errorf("hotplugUsb(%s) attaching %s failed: %s",
+				status.Key(), ib.Phylabel, err)
+			return
+		}
+		log.Noticef("hotplugUsb(%s) attached %s at %s", status.Key(),
+			ib.Phylabel, ib.UsbAddr)
+		return
+	}
+	if err := task.DetachUsb(status.DomainName, status.DomainId, ib.UsbAddr); err != nil {
+		log.Errorf("hotplugUsb(%s) detaching %s failed: %s",
+			status.Key(), ib.Phylabel, err)
+		return
+	}
+	log.Noticef("hotplugUsb(%s) detached %s from %s", status.Key(),
+		ib.Phylabel, ib.UsbAddr)
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package domainmgr
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadUsbDevices(t *testing.T) {
+	log = base.NewSourceLogObject(logrus.StandardLogger(), "domainmgr", 0)
+	dir, err := ioutil.TempDir("", "usb")
+	if err != nil {
+		t.Fatalf("can't create temp dir %v", err)
+	}
+	defer os.RemoveAll(dir)
+	sysfs := map[string]map[string]string{
+		"usb1":    {"busnum": "1", "devnum": "1", "idVendor": "1d6b", "idProduct": "0002"},
+		"1-2":     {"busnum": "1", "devnum": "5", "idVendor": "0bda", "idProduct": "8153", "serial": "000001\n"},
+		"1-2:1.0": {"bInterfaceClass": "ff"},
+		"1-3":     {"busnum": "1", "devnum": "7", "idVendor": "046d", "idProduct": "c52b"},
+		"1-4":     {"busnum": "1"},
+	}
+	for name, attrs := range sysfs {
+		os.MkdirAll(filepath.Join(dir, name), 0755)
+		for attr, value := range attrs {
+			ioutil.WriteFile(filepath.Join(dir, name, attr), []byte(value+"\n"), 0644)
+		}
+	}
+	devices, err := readUsbDevices(dir)
+	if err != nil {
+		t.Fatalf("readUsbDevices failed: %v", err)
+	}
+	assert.Equal(t, map[string]usbDevice{
+		"1:5": {addr: "1:5", product: "0bda:8153", serial: "000001"},
+		"1:7": {addr: "1:7", product: "046d:c52b"},
+	}, devices)
+}
+
+func TestIsUsbDeviceUevent(t *testing.T) {
+	testMatrix := map[string]struct {
+		fields   []string
+		expected bool
+	}{
+		"device": {
+			fields:   []string{"add@/devices/pci0000:00/0000:00:14.0/usb1/1-2", "ACTION=add", "SUBSYSTEM=usb", "DEVTYPE=usb_device"},
+			expected: true,
+		},
+		"interface": {
+			fields: []string{"add@/devices/pci0000:00/0000:00:14.0/usb1/1-2/1-2:1.0", "ACTION=add", "SUBSYSTEM=usb", "DEVTYPE=usb_interface"},
+		},
+		"other subsystem": {
+			fields: []string{"add@/devices/virtual/net/tap0", "ACTION=add", "SUBSYSTEM=net"},
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		msg := []byte(strings.Join(test.fields, "\x00") + "\x00")
+		if got := isUsbDeviceUevent(msg); got != test.expected {
+			t.Errorf("%s: got %t expected %t", testname, got, test.expected)
+		}
+	}
+}
+
+func TestUpdateUsbBundles(t *testing.T) {
+	log = base.NewSourceLogObject(logrus.StandardLogger(), "domainmgr", 0)
+	aa := types.AssignableAdapters{
+		IoBundleList: []types.IoBundle{
+			{Phylabel: "USB0", UsbAddr: "1:3"},
+			{Phylabel: "dongle", UsbProduct: "0bda:8153"},
+			{Phylabel: "keyed", UsbProduct: "0BDA:8153", UsbSerial: "000002"},
+			{Phylabel: "static", UsbProduct: "046d:c52b", UsbAddr: "1:9"},
+		},
+	}
+	var hotplugs []string
+	hotplug := func(ib types.IoBundle, attach bool) {
+		op := "detach"
+		if attach {
+			op = "attach"
+		}
+		hotplugs = append(hotplugs, op+" "+ib.Phylabel+" "+ib.UsbAddr)
+	}
+	usbAddrs := func() []string {
+		var addrs []string
+		for _, ib := range aa.IoBundleList {
+			addrs = append(addrs, ib.UsbAddr)
+		}
+		return addrs
+	}
+
+	// the serial rule gets its device even though the generic rule
+	// comes first; the device of USB0 isn't taken by rules
+	devices := map[string]usbDevice{
+		"1:3": {addr: "1:3", product: "046d:c52b"},
+		"1:5": {addr: "1:5", product: "0bda:8153", serial: "000001"},
+		"1:6": {addr: "1:6", product: "0bda:8153", serial: "000002"},
+	}
+	assert.True(t, updateUsbBundles(&aa, devices, hotplug))
+	assert.Equal(t, []string{"1:3", "1:5", "1:6", ""}, usbAddrs())
+	assert.Equal(t, []string{"detach static 1:9", "attach keyed 1:6", "attach dongle 1:5"}, hotplugs)
+
+	// nothing changed
+	hotplugs = nil
+	assert.False(t, updateUsbBundles(&aa, devices, hotplug))
+	assert.Empty(t, hotplugs)
+
+	// the dongle is replaced and comes back on another address
+	delete(devices, "1:5")
+	assert.True(t, updateUsbBundles(&aa, devices, hotplug))
+	assert.Equal(t, []string{"1:3", "", "1:6", ""}, usbAddrs())
+	devices["1:8"] = usbDevice{addr: "1:8", product: "0bda:8153", serial: "000003"}
+	assert.True(t, updateUsbBundles(&aa, devices, hotplug))
+	assert.Equal(t, []string{"1:3", "1:8", "1:6", ""}, usbAddrs())
+	assert.Equal(t, []string{"detach dongle 1:5", "attach dongle 1:8"}, hotplugs)
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Domains with EnableVTPM get a TPM 2.0 emulated by swtpm. Its state lives
+// in the vault, hence it survives reboots and updates of EVE, and goes away
+// together with the app instance.
+
+package domainmgr
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	uuid "github.com/satori/go.uuid"
+)
+
+// vtpmStateRoot is a variable for the sake of the tests
+var vtpmStateRoot = types.VTPMStateDirName
+
+func vtpmStateDir(domainUUID uuid.UUID) string {
+	return filepath.Join(vtpmStateRoot, domainUUID.String())
+}
+
+// provisionVTPM creates the state directory of the TPM of the domain, if
+// it has one, and sets VTPMStateDir for the hypervisor to run swtpm on it
+func provisionVTPM(hyperName string, config types.DomainConfig,
+	status *types.DomainStatus) error {
+
+	status.VTPMStateDir = ""
+	if !config.EnableVTPM {
+		return nil
+	}
+	if hyperName != "kvm" || config.VirtualizationMode == types.NOHYPER {
+		return fmt.Errorf("a TPM is only supported for VMs on kvm, not on %s",
+			hyperName)
+	}
+	dir := vtpmStateDir(config.UUIDandVersion.UUID)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("failed to create the TPM state of %s: %v",
+			config.Key(), err)
+	}
+	status.VTPMStateDir = dir
+	return nil
+}
+
+// removeVTPM deletes the state of the TPM of a domain which is gone for good
+func removeVTPM(domainUUID uuid.UUID) {
+	dir := vtpmStateDir(domainUUID)
+	if _, err := os.Stat(dir); err != nil {
+		return
+	}
+	log.Noticef("removeVTPM: deleting %s", dir)
+	if err := os.RemoveAll(dir); err != nil {
+		log.Errorf("removeVTPM: %s", err)
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package domainmgr
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	uuid "github.com/satori/go.uuid"
+	"github.com/sirupsen/logrus"
+)
+
+func TestProvisionVTPM(t *testing.T) {
+	log = base.NewSourceLogObject(logrus.StandardLogger(), "domainmgr", 0)
+	dir, err := ioutil.TempDir("", "vtpm")
+	if err != nil {
+		t.Fatalf("can't create temp dir %v", err)
+	}
+	defer os.RemoveAll(dir)
+	vtpmStateRoot = dir
+	defer func() { vtpmStateRoot = types.VTPMStateDirName }()
+
+	domainUUID := uuid.NewV4()
+	stateDir := filepath.Join(dir, domainUUID.String())
+	testMatrix := map[string]struct {
+		hyperName string
+		vmConfig  types.VmConfig
+		stateDir  string
+		fail      bool
+	}{
+		"no TPM":    {hyperName: "kvm", vmConfig: types.VmConfig{}},
+		"kvm":       {hyperName: "kvm", vmConfig: types.VmConfig{EnableVTPM: true}, stateDir: stateDir},
+		"xen":       {hyperName: "xen", vmConfig: types.VmConfig{EnableVTPM: true}, fail: true},
+		"container": {hyperName: "kvm", vmConfig: types.VmConfig{EnableVTPM: true, VirtualizationMode: types.NOHYPER}, fail: true},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		config := types.DomainConfig{
+			UUIDandVersion: types.UUIDandVersion{UUID: domainUUID},
+			VmConfig:       test.vmConfig,
+		}
+		status := types.DomainStatus{VTPMStateDir: "stale"}
+		err := provisionVTPM(test.hyperName, config, &status)
+		if (err != nil) != test.fail {
+			t.Errorf("%s: unexpected error %v", testname, err)
+		}
+		if status.VTPMStateDir != test.stateDir {
+			t.Errorf("%s: got VTPMStateDir %s expected %s", testname,
+				status.VTPMStateDir, test.stateDir)
+		}
+	}
+
+	info, err := os.Stat(stateDir)
+	if err != nil || info.Mode().Perm() != 0700 {
+		t.Fatalf("got state directory %v (%v)", info, err)
+	}
+	// the state survives activating the domain again
+	ioutil.WriteFile(filepath.Join(stateDir, "tpm2-00.permall"), []byte("state"), 0600)
+	config := types.DomainConfig{
+		UUIDandVersion: types.UUIDandVersion{UUID: domainUUID},
+		VmConfig:       types.VmConfig{EnableVTPM: true},
+	}
+	if err := provisionVTPM("kvm", config, &types.DomainStatus{}); err != nil {
+		t.Errorf("provisionVTPM failed again: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(stateDir, "tpm2-00.permall")); err != nil {
+		t.Errorf("state of the TPM is gone: %v", err)
+	}
+	removeVTPM(domainUUID)
+	if _, err := os.Stat(stateDir); !os.IsNotExist(err) {
+		t.Errorf("state directory is still there: %v", err)
+	}
+}
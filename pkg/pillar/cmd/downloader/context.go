@@ -24,6 +24,7 @@ type downloaderContext struct {
 	subGlobalConfig        pubsub.Subscription
 	GCInitialized          bool
 	downloadMaxPortCost    uint8
+	downloadParallelRanges int
 }
 
 func (ctx *downloaderContext) registerHandlers(ps *pubsub.PubSub) error {
@@ -4,8 +4,13 @@
 package downloader
 
 import (
+	"io/ioutil"
 	"os"
 	"path"
+	"strings"
+	"time"
+
+	zedHttp "github.com/lf-edge/eve/libs/zedUpload/httputil"
 )
 
 // Create the object download directories we own
@@ -24,15 +29,34 @@ func createDownloadDirs() {
 	}
 }
 
-// clear in-progress object download directories
+// A partial download which has not made progress for this long is not
+// resumed but removed, so that the partial download of a blob which is no
+// longer asked for does not stay around forever
+const maxResumeAge = 7 * 24 * time.Hour
+
+// clear in-progress object download directories, keeping the partial
+// downloads which can be resumed and their checkpoints
 func clearInProgressDownloadDirs() {
 
 	// Now remove the in-progress dirs
 	workingDirTypes := []string{getPendingDir()}
 
 	for _, dirName := range workingDirTypes {
-		if _, err := os.Stat(dirName); err == nil {
-			if err := os.RemoveAll(dirName); err != nil {
+		locations, err := ioutil.ReadDir(dirName)
+		if err != nil {
+			continue
+		}
+		for _, location := range locations {
+			filename := path.Join(dirName, location.Name())
+			partial := strings.TrimSuffix(filename, zedHttp.CheckpointSuffix)
+			if !location.IsDir() && zedHttp.CanResume(partial) &&
+				time.Since(location.ModTime()) < maxResumeAge {
+				log.Noticef("clearInProgressDownloadDirs: keeping %s to resume",
+					filename)
+				continue
+			}
+			log.Functionf("clearInProgressDownloadDirs: removing %s", filename)
+			if err := os.RemoveAll(filename); err != nil {
 				log.Fatal(err)
 			}
 		}
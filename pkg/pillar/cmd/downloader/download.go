@@ -51,6 +51,9 @@ func download(ctx *downloaderContext, trType zedUpload.SyncTransportType,
 	} else {
 		dEndPoint.WithSrcIPSelection(ipSrc)
 	}
+	if ep, ok := dEndPoint.(*zedUpload.HttpTransportMethod); ok {
+		ep.WithParallelRanges(ctx.downloadParallelRanges)
+	}
 
 	var respChan = make(chan *zedUpload.DronaRequest)
 
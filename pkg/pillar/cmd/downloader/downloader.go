@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/lf-edge/eve/libs/zedUpload"
+	zedHttp "github.com/lf-edge/eve/libs/zedUpload/httputil"
 	"github.com/lf-edge/eve/pkg/pillar/base"
 	"github.com/lf-edge/eve/pkg/pillar/cipher"
 	"github.com/lf-edge/eve/pkg/pillar/flextimer"
@@ -400,6 +401,15 @@ func doDelete(ctx *downloaderContext, key string, filename string,
 				filename, err)
 		}
 	}
+	// and what is left of a partial download
+	checkpoint := zedHttp.CheckpointFile(filename)
+	if _, err := os.Stat(checkpoint); err == nil {
+		log.Functionf("Deleting %s", checkpoint)
+		if err := os.Remove(checkpoint); err != nil {
+			log.Errorf("Failed to remove %s: err %s",
+				checkpoint, err)
+		}
+	}
 
 	status.State = types.INITIAL
 
@@ -467,6 +477,9 @@ func downloaderInit(ctx *downloaderContext) *zedUpload.DronaCtx {
 		log.Fatal(err)
 	}
 	// Remove any files which didn't complete before the device reboot
+	// unless they can be resumed. A partial download is resumed when
+	// volumemgr asks for the same blob again and removed by doDelete when
+	// the blob is no longer needed or when it is older than maxResumeAge.
 	clearInProgressDownloadDirs()
 	createDownloadDirs()
 	return dCtx
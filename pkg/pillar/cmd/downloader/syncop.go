@@ -12,6 +12,7 @@ import (
 
 	zconfig "github.com/lf-edge/eve/api/go/config"
 	"github.com/lf-edge/eve/libs/zedUpload"
+	zedHttp "github.com/lf-edge/eve/libs/zedUpload/httputil"
 	"github.com/lf-edge/eve/pkg/pillar/cipher"
 	"github.com/lf-edge/eve/pkg/pillar/types"
 	"github.com/lf-edge/eve/pkg/pillar/zedcloud"
@@ -235,8 +236,15 @@ func handleSyncOpResponse(ctx *downloaderContext, config types.DownloaderConfig,
 	// management also
 
 	if errStr != "" {
-		// Delete file, and update the storage
-		doDelete(ctx, key, locFilename, status)
+		if zedHttp.CanResume(locFilename) {
+			// Keep the partial download for the retry to resume
+			log.Noticef("handleSyncOpResponse(%s): keeping %s to resume",
+				status.Name, locFilename)
+			status.State = types.INITIAL
+		} else {
+			// Delete file, and update the storage
+			doDelete(ctx, key, locFilename, status)
+		}
 		status.HandleDownloadFail(errStr, retryTime)
 		publishDownloaderStatus(ctx, status)
 		log.Errorf("handleSyncOpResponse(%s): failed with %s",
@@ -11,16 +11,24 @@
 // For agents with agentScope, such as downloader and verifier, use e.g.,
 // ipcmonitor -a zedmanager -s appImg.obj -t DownloaderConfiga
 //     which corresponds to /run/zedmanager/appImg.obj/DownloaderConfig/
+//
+// To list all publications and subscriptions in zedbox use
+// ipcmonitor -i topics
+//     and ipcmonitor -i graph.dot for the agent/topic graph.
 
 package ipcmonitor
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"flag"
 	"fmt"
+	"io"
 	"net"
+	"net/http"
+	"os"
 	"strings"
 
 	"github.com/lf-edge/eve/pkg/pillar/base"
@@ -45,6 +53,7 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	debugPtr := flag.Bool("d", false, "Debug flag")
 	persistentPtr := flag.Bool("P", false, "Persistent flag")
 	formatPtr := flag.String("f", "go", "format flag, defaults to 'go', supports: 'go', 'json'")
+	introspectPtr := flag.String("i", "", "Query zedbox for all topics; supports: 'topics', 'graph', 'graph.dot'")
 	flag.Parse()
 	agentName := *agentNamePtr
 	agentScope := *agentScopePtr
@@ -59,6 +68,9 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 		testPersistent(ps, agentName, agentScope, topic)
 		return 0
 	}
+	if *introspectPtr != "" {
+		return introspect(*introspectPtr)
+	}
 	format := *formatPtr
 
 	name := nameString(agentName, agentScope, topic)
@@ -155,6 +167,34 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	}
 }
 
+// introspect fetches what from the pubsub introspection socket served
+// by zedbox and prints it
+func introspect(what string) int {
+	client := http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, "unix", types.PubSubIntrospectSocket)
+			},
+		},
+	}
+	resp, err := client.Get("http://zedbox/" + what)
+	if err != nil {
+		log.Errorf("introspect(%s): %v", what, err)
+		return 1
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		log.Errorf("introspect(%s): %s", what, resp.Status)
+		return 1
+	}
+	if _, err := io.Copy(os.Stdout, resp.Body); err != nil {
+		log.Errorf("introspect(%s): %v", what, err)
+		return 1
+	}
+	return 0
+}
+
 func nameString(agentname, agentscope, topic string) string {
 	if agentscope == "" {
 		return fmt.Sprintf("%s/%s", agentname, topic)
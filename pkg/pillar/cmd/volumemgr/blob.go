@@ -22,6 +22,10 @@ func downloadBlob(ctx *volumemgrContext, blob *types.BlobStatus) bool {
 		if !ctx.globalConfig.GlobalValueBool(types.IgnoreDiskCheckForApps) {
 			// Check disk usage
 			remaining, err := getRemainingDiskSpace(ctx)
+			if err == nil && remaining < blob.Size {
+				remaining, err = reclaimDiskSpace(ctx, remaining,
+					blob.Size, "blob "+blob.Sha256)
+			}
 			if err != nil {
 				errStr := fmt.Sprintf("getRemainingDiskSpace failed: %s\n",
 					err)
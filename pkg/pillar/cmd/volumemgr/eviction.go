@@ -0,0 +1,184 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Evict the content trees kept around by DeferContentDelete ahead of their
+// time when /persist fills up: from the high watermark down to the low one,
+// and whenever a download or a volume would not fit otherwise. The least
+// recently used go first; pinned ones such as the EVE images are never
+// evicted.
+
+package volumemgr
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/shirou/gopsutil/disk"
+)
+
+// maxRecentEvictions is how many evictions ContentEvictionStatus reports
+const maxRecentEvictions = 16
+
+// selectEvictions returns the indices of the candidates to evict, least
+// recently used first, to reclaim at least needed bytes, and how many bytes
+// that is. Pinned candidates and those inUse are skipped. With allOrNothing
+// nothing is selected unless all of needed can be reclaimed. A blob is only
+// reclaimed once all the content trees referring to it are evicted hence
+// blobs shared with other content trees don't count.
+func selectEvictions(candidates []timeAndContentTreeStatus, needed uint64,
+	allOrNothing bool, inUse func(*types.ContentTreeStatus) bool,
+	lookupBlob func(sha string) *types.BlobStatus) ([]int, uint64) {
+
+	var order []int
+	for i, tc := range candidates {
+		if tc.status.Pinned || inUse(tc.status) {
+			continue
+		}
+		order = append(order, i)
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return candidates[order[i]].status.LastUse.Before(
+			candidates[order[j]].status.LastUse)
+	})
+	var selected []int
+	var reclaimed uint64
+	dropped := make(map[string]uint) // References to each blob dropped
+	for _, i := range order {
+		if reclaimed >= needed {
+			break
+		}
+		selected = append(selected, i)
+		for _, sha := range candidates[i].status.Blobs {
+			blob := lookupBlob(sha)
+			if blob == nil {
+				continue
+			}
+			dropped[sha]++
+			if dropped[sha] == blob.RefCount {
+				reclaimed += uint64(blob.CurrentSize)
+			}
+		}
+	}
+	if allOrNothing && reclaimed < needed {
+		return nil, 0
+	}
+	return selected, reclaimed
+}
+
+// evictionNeeded returns how many bytes to reclaim to get the usage down
+// to the low watermark once it reached the high watermark (in percent)
+func evictionNeeded(used, total uint64, high, low uint32) uint64 {
+	if total == 0 || used*100 < total*uint64(high) {
+		return 0
+	}
+	target := total * uint64(low) / 100
+	if used <= target {
+		return 0
+	}
+	return used - target
+}
+
+// contentTreeReferenced checks if a content tree waiting for its deferred
+// delete is in the config again
+func contentTreeReferenced(ctx *volumemgrContext, key string) bool {
+	if lookupContentTreeConfig(ctx, key) != nil {
+		return true
+	}
+	c, _ := ctx.subBaseOsContentTreeConfig.Get(key)
+	return c != nil
+}
+
+// evictContent deletes content trees from deferredDelete to reclaim needed
+// bytes and reports them with the reason. Returns how many bytes that was.
+func evictContent(ctx *volumemgrContext, needed uint64, allOrNothing bool,
+	reason string) uint64 {
+
+	selected, reclaimed := selectEvictions(deferredDelete, needed, allOrNothing,
+		func(status *types.ContentTreeStatus) bool {
+			return contentTreeReferenced(ctx, status.Key())
+		},
+		func(sha string) *types.BlobStatus {
+			return lookupBlobStatus(ctx, sha)
+		})
+	if len(selected) == 0 {
+		log.Functionf("evictContent: nothing to evict for %s", reason)
+		return 0
+	}
+	evicted := make(map[int]bool)
+	now := time.Now()
+	for _, i := range selected {
+		tc := deferredDelete[i]
+		log.Noticef("evictContent: evicting %s (%s) of %d bytes last used %v: %s",
+			tc.status.Key(), tc.status.DisplayName, tc.status.CurrentSize,
+			tc.status.LastUse, reason)
+		doDeleteContentTree(ctx, tc.status)
+		evicted[i] = true
+		ctx.evictionStatus.Evictions++
+		ctx.evictionStatus.Recent = append(ctx.evictionStatus.Recent,
+			types.ContentEviction{
+				ContentID:   tc.status.ContentID,
+				DisplayName: tc.status.DisplayName,
+				RelativeURL: tc.status.RelativeURL,
+				Size:        tc.status.CurrentSize,
+				LastUse:     tc.status.LastUse,
+				EvictedAt:   now,
+				Reason:      reason,
+			})
+	}
+	ctx.evictionStatus.EvictedBytes += reclaimed
+	if n := len(ctx.evictionStatus.Recent); n > maxRecentEvictions {
+		ctx.evictionStatus.Recent = ctx.evictionStatus.Recent[n-maxRecentEvictions:]
+	}
+	newDD := make([]timeAndContentTreeStatus, 0)
+	for i, tc := range deferredDelete {
+		if !evicted[i] {
+			newDD = append(newDD, tc)
+		}
+	}
+	deferredDelete = newDD
+	ctx.pubContentEvictionStatus.Publish(ctx.evictionStatus.Key(),
+		ctx.evictionStatus)
+	return reclaimed
+}
+
+// checkDiskWatermarks evicts content once the usage of /persist reached
+// the high watermark until it is down to the low watermark
+func checkDiskWatermarks(ctx *volumemgrContext) {
+	if len(deferredDelete) == 0 {
+		return
+	}
+	usage, err := disk.Usage(types.PersistDir)
+	if err != nil {
+		log.Errorf("checkDiskWatermarks: failed to get the usage of %s: %s",
+			types.PersistDir, err)
+		return
+	}
+	high := ctx.globalConfig.GlobalValueInt(types.ContentEvictHighWatermark)
+	low := ctx.globalConfig.GlobalValueInt(types.ContentEvictLowWatermark)
+	needed := evictionNeeded(usage.Used, usage.Total, high, low)
+	if needed == 0 {
+		return
+	}
+	evictContent(ctx, needed, false,
+		fmt.Sprintf("usage of %s at %.0f%% reached the high watermark of %d%%",
+			types.PersistDir, usage.UsedPercent, high))
+}
+
+// reclaimDiskSpace is called when what needs more than the remaining disk
+// space and evicts content if that makes it fit. Returns the remaining disk
+// space afterwards.
+func reclaimDiskSpace(ctx *volumemgrContext, remaining uint64, needed uint64,
+	what string) (uint64, error) {
+
+	if remaining >= needed {
+		return remaining, nil
+	}
+	reason := fmt.Sprintf("%s needs %d bytes with %d remaining",
+		what, needed, remaining)
+	if evictContent(ctx, needed-remaining, true, reason) == 0 {
+		return remaining, nil
+	}
+	return getRemainingDiskSpace(ctx)
+}
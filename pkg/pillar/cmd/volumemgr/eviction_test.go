@@ -0,0 +1,89 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package volumemgr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvictionNeeded(t *testing.T) {
+	testMatrix := map[string]struct {
+		used, total uint64
+		high, low   uint32
+		expected    uint64
+	}{
+		"below high":   {used: 89, total: 100, high: 90, low: 80, expected: 0},
+		"at high":      {used: 90, total: 100, high: 90, low: 80, expected: 10},
+		"full":         {used: 1000, total: 1000, high: 90, low: 80, expected: 200},
+		"low is high":  {used: 95, total: 100, high: 90, low: 90, expected: 5},
+		"low above":    {used: 95, total: 100, high: 90, low: 99, expected: 0},
+		"no partition": {used: 0, total: 0, high: 90, low: 80, expected: 0},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		needed := evictionNeeded(test.used, test.total, test.high, test.low)
+		assert.Equal(t, test.expected, needed, testname)
+	}
+}
+
+func TestSelectEvictions(t *testing.T) {
+	now := time.Now()
+	candidate := func(name string, age time.Duration, pinned bool,
+		blobs ...string) timeAndContentTreeStatus {
+		return timeAndContentTreeStatus{
+			deleteTime: now.Add(time.Hour - age),
+			status: &types.ContentTreeStatus{
+				DisplayName: name,
+				Pinned:      pinned,
+				LastUse:     now.Add(-age),
+				Blobs:       blobs,
+			},
+		}
+	}
+	candidates := []timeAndContentTreeStatus{
+		candidate("eve", 4*time.Minute, true, "eve"),
+		candidate("old", 3*time.Minute, false, "old"),
+		candidate("back", 2*time.Minute, false, "back"),
+		candidate("new", 1*time.Minute, false, "new", "shared"),
+		// Shares a blob with back which is in use and one with new
+		candidate("sharing", 5*time.Minute, false, "back", "shared"),
+	}
+	blobs := map[string]*types.BlobStatus{
+		"eve":    {CurrentSize: 300, RefCount: 1},
+		"old":    {CurrentSize: 100, RefCount: 1},
+		"back":   {CurrentSize: 500, RefCount: 2},
+		"new":    {CurrentSize: 200, RefCount: 1},
+		"shared": {CurrentSize: 50, RefCount: 2},
+	}
+	inUse := func(status *types.ContentTreeStatus) bool {
+		return status.DisplayName == "back"
+	}
+	lookupBlob := func(sha string) *types.BlobStatus {
+		return blobs[sha]
+	}
+	testMatrix := map[string]struct {
+		needed       uint64
+		allOrNothing bool
+		expected     []int
+		reclaimed    uint64
+	}{
+		"nothing":          {needed: 0, expected: nil, reclaimed: 0},
+		"least recent":     {needed: 50, expected: []int{4, 1}, reclaimed: 100},
+		"more":             {needed: 150, expected: []int{4, 1, 3}, reclaimed: 350},
+		"too much":         {needed: 1000, expected: []int{4, 1, 3}, reclaimed: 350},
+		"too much for all": {needed: 1000, allOrNothing: true, expected: nil, reclaimed: 0},
+		"enough for all":   {needed: 350, allOrNothing: true, expected: []int{4, 1, 3}, reclaimed: 350},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		selected, reclaimed := selectEvictions(candidates, test.needed,
+			test.allOrNothing, inUse, lookupBlob)
+		assert.Equal(t, test.expected, selected, testname)
+		assert.Equal(t, test.reclaimed, reclaimed, testname)
+	}
+}
@@ -35,6 +35,7 @@ func handleContentTreeModify(ctxArg interface{}, key string,
 	if status == nil {
 		log.Fatalf("Missing ContentTreeStatus for %s", config.Key())
 	}
+	updateContentTreePinned(ctx, status, config)
 	updateContentTree(ctx, status)
 	log.Functionf("handleContentTree(%s) Done", key)
 }
@@ -61,6 +62,20 @@ func handleContentTreeRestart(ctxArg interface{}, restartCounter int) {
 	}
 }
 
+// updateContentTreePinned picks up a change to Pinned in the config, which
+// the controller can pin or unpin at any time
+func updateContentTreePinned(ctx *volumemgrContext, status *types.ContentTreeStatus,
+	config types.ContentTreeConfig) {
+
+	if status.Pinned == config.Pinned {
+		return
+	}
+	log.Functionf("updateContentTreePinned(%s) from %t to %t",
+		status.Key(), status.Pinned, config.Pinned)
+	status.Pinned = config.Pinned
+	publishContentTreeStatus(ctx, status)
+}
+
 func publishContentTreeStatus(ctx *volumemgrContext, status *types.ContentTreeStatus) {
 
 	key := status.Key()
@@ -159,6 +174,8 @@ func createContentTreeStatus(ctx *volumemgrContext, config types.ContentTreeConf
 			MaxDownloadSize:   config.MaxDownloadSize,
 			GenerationCounter: config.GenerationCounter,
 			DisplayName:       config.DisplayName,
+			Pinned:            config.Pinned,
+			LastUse:           time.Now(),
 			State:             types.INITIAL,
 			Blobs:             []string{},
 		}
@@ -190,6 +207,9 @@ func createContentTreeStatus(ctx *volumemgrContext, config types.ContentTreeConf
 			}
 			AddBlobsToContentTreeStatus(ctx, status, strings.ToLower(config.ContentSha256))
 		}
+	} else {
+		// Added back while waiting for its deferred delete
+		updateContentTreePinned(ctx, status, config)
 	}
 	publishContentTreeStatus(ctx, status)
 	log.Functionf("createContentTreeStatus for %v Done", config.ContentID)
@@ -271,7 +291,15 @@ func deleteContentTree(ctx *volumemgrContext, status *types.ContentTreeStatus) {
 		}
 		log.Noticef("Deferring delete of %s to %v",
 			status.Key(), expiry)
-		deferredDelete = append(deferredDelete, tc)
+		// It might have been deleted before the config brought it back,
+		// in which case the earlier entry has a stale LastUse
+		newDD := make([]timeAndContentTreeStatus, 0)
+		for _, old := range deferredDelete {
+			if old.status.Key() != status.Key() {
+				newDD = append(newDD, old)
+			}
+		}
+		deferredDelete = append(newDD, tc)
 	}
 }
 
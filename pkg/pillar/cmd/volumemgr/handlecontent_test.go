@@ -0,0 +1,42 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package volumemgr
+
+import (
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	uuid "github.com/satori/go.uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContentTreePinned(t *testing.T) {
+	logger := logrus.StandardLogger()
+	log = base.NewSourceLogObject(logger, "test", 1234)
+	ps := pubsub.New(&pubsub.EmptyDriver{}, logger, log)
+	pub, err := ps.NewPublication(pubsub.PublicationOptions{
+		AgentName:  agentName,
+		AgentScope: types.AppImgObj,
+		TopicType:  types.ContentTreeStatus{},
+	})
+	assert.Nil(t, err)
+	ctx := &volumemgrContext{pubContentTreeStatus: pub}
+
+	config := types.ContentTreeConfig{ContentID: uuid.NewV4()}
+	status := types.ContentTreeStatus{ContentID: config.ContentID}
+	publishContentTreeStatus(ctx, &status)
+
+	// Pinned by a modify
+	config.Pinned = true
+	updateContentTreePinned(ctx, lookupContentTreeStatus(ctx, config.Key()), config)
+	assert.True(t, lookupContentTreeStatus(ctx, config.Key()).Pinned)
+
+	// Unpinned when added back while its delete was deferred
+	config.Pinned = false
+	createContentTreeStatus(ctx, config)
+	assert.False(t, lookupContentTreeStatus(ctx, config.Key()).Pinned)
+}
@@ -7,7 +7,6 @@ import (
 	"path"
 
 	"github.com/lf-edge/eve/pkg/pillar/types"
-	uuid "github.com/satori/go.uuid"
 )
 
 // AddOrRefcountDownloaderConfig used to publish the downloader config
@@ -42,9 +41,10 @@ func AddOrRefcountDownloaderConfig(ctx *volumemgrContext, blob types.BlobStatus)
 	}
 
 	// where should the final downloaded file be?
-	// Pick a unique name since the sha has not yet been verified hence
-	// can potentially collide between different concurrent downloads
-	pendingFile := uuid.NewV4().String() + "." + blob.Sha256
+	// DownloaderConfig is keyed by the sha hence there is at most one
+	// download per sha. Use the same name every time so a partial download
+	// left behind by a failure or a reboot can be resumed.
+	pendingFile := blob.Sha256
 	locFilename := path.Join(types.SealedDirName, "downloader", "pending",
 		pendingFile)
 
@@ -141,6 +141,10 @@ func handleDeferredVolumeCreate(ctx *volumemgrContext, key string, config *types
 	if !ctx.globalConfig.GlobalValueBool(types.IgnoreDiskCheckForApps) {
 		// Check disk usage
 		remaining, err := getRemainingDiskSpace(ctx)
+		if err == nil && remaining < status.MaxVolSize {
+			remaining, err = reclaimDiskSpace(ctx, remaining,
+				status.MaxVolSize, "volume "+status.Key())
+		}
 		if err != nil {
 			errStr := fmt.Sprintf("getRemainingDiskSpace failed: %s\n",
 				err)
@@ -482,6 +482,9 @@ func doUpdateVol(ctx *volumemgrContext, status *types.VolumeStatus) (bool, bool)
 			changed = true
 			// Asynch creation; ensure we have requested it
 			AddWorkCreate(ctx, status)
+			// For evicting the least recently used content first
+			ctStatus.LastUse = time.Now()
+			publishContentTreeStatus(ctx, ctStatus)
 		}
 		if status.IsErrorSource(types.ContentTreeStatus{}) {
 			log.Functionf("doUpdate: Clearing volume error %s", status.Error)
@@ -87,6 +87,10 @@ type volumemgrContext struct {
 	casClient cas.CAS
 
 	volumeConfigCreateDeferredMap map[string]*types.VolumeConfig
+
+	// What evictContent evicted
+	pubContentEvictionStatus pubsub.Publication
+	evictionStatus           types.ContentEvictionStatus
 }
 
 var debug = false
@@ -233,6 +237,17 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	}
 	ctx.pubBlobStatus = pubBlobStatus
 
+	pubContentEvictionStatus, err := ps.NewPublication(
+		pubsub.PublicationOptions{
+			AgentName: agentName,
+			TopicType: types.ContentEvictionStatus{},
+		},
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctx.pubContentEvictionStatus = pubContentEvictionStatus
+
 	pubDiskMetric, err := ps.NewPublication(
 		pubsub.PublicationOptions{
 			AgentName: agentName,
@@ -548,6 +563,7 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 		case <-ctx.deferDelete.C:
 			start := time.Now()
 			checkDeferredDelete(&ctx)
+			checkDiskWatermarks(&ctx)
 			ps.CheckMaxTimeTopic(agentName, "deferDelete", start,
 				warningTime, errorTime)
 
@@ -65,6 +65,7 @@ func parseContentInfoConfig(ctx *getconfigContext,
 		contentConfig.ContentSha256 = strings.ToLower(cfgContentTree.GetSha256())
 		contentConfig.MaxDownloadSize = cfgContentTree.GetMaxSizeBytes()
 		contentConfig.DisplayName = cfgContentTree.GetDisplayName()
+		contentConfig.Pinned = cfgContentTree.GetPinned()
 		publishContentTreeConfig(ctx, *contentConfig)
 	}
 	ctx.pubContentTreeConfig.SignalRestarted()
@@ -122,3 +123,22 @@ func handleContentTreeStatusDelete(ctxArg interface{}, key string,
 	PublishContentInfoToZedCloud(ctx, uuidStr, nil, ctx.iteration)
 	ctx.iteration++
 }
+
+func handleContentEvictionStatusCreate(ctxArg interface{}, key string,
+	statusArg interface{}) {
+	handleContentEvictionStatusImpl(ctxArg, key, statusArg)
+}
+
+func handleContentEvictionStatusModify(ctxArg interface{}, key string,
+	statusArg interface{}, oldStatusArg interface{}) {
+	handleContentEvictionStatusImpl(ctxArg, key, statusArg)
+}
+
+// handleContentEvictionStatusImpl reports the evictions in the device info
+func handleContentEvictionStatusImpl(ctxArg interface{}, key string,
+	statusArg interface{}) {
+
+	ctx := ctxArg.(*zedagentContext)
+	log.Functionf("handleContentEvictionStatusImpl(%s)", key)
+	triggerPublishDevInfo(ctx)
+}
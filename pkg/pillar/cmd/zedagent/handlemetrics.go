@@ -203,6 +203,10 @@ func lookupDomainMetric(ctx *zedagentContext, uuidStr string) *types.DomainMetri
 func lookupAppContainerMetric(ctx *zedagentContext, uuidStr string) *types.AppContainerMetrics {
 	sub := ctx.subAppContainerMetrics
 	m, _ := sub.Get(uuidStr)
+	if m == nil {
+		// Container apps run as containerd tasks
+		m, _ = ctx.subTaskContainerMetrics.Get(uuidStr)
+	}
 	if m == nil {
 		return nil
 	}
@@ -922,6 +926,9 @@ func PublishAppInfoToZedCloud(ctx *zedagentContext, uuid string,
 			bootTime, _ := ptypes.TimestampProto(aiStatus.BootTime)
 			ReportAppInfo.BootTime = bootTime
 		}
+		ReportAppInfo.RestartCount = aiStatus.RestartCount
+		ReportAppInfo.CrashLoop = aiStatus.CrashLoop
+		ReportAppInfo.LastExitReason = aiStatus.LastExitReason
 
 		for _, ia := range aiStatus.IoAdapterList {
 			reportAA := new(info.ZioBundle)
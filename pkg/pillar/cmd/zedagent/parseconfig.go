@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"hash"
 	"io/ioutil"
+	"math"
 	"net"
 	"os"
 	"sort"
@@ -19,6 +20,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	zconfig "github.com/lf-edge/eve/api/go/config"
+	"github.com/lf-edge/eve/pkg/pillar/containerd"
 	"github.com/lf-edge/eve/pkg/pillar/ssh"
 	"github.com/lf-edge/eve/pkg/pillar/types"
 	fileutils "github.com/lf-edge/eve/pkg/pillar/utils/file"
@@ -467,10 +469,24 @@ func parseAppInstanceConfig(config *zconfig.EdgeDevConfig,
 		appInstance.FixedResources.Memory = int(cfgApp.Fixedresources.Memory)
 		appInstance.FixedResources.RootDev = cfgApp.Fixedresources.Rootdev
 		appInstance.FixedResources.VCpus = int(cfgApp.Fixedresources.Vcpus)
+		appInstance.FixedResources.CPUs = cfgApp.Fixedresources.Cpus
 		appInstance.FixedResources.VirtualizationMode = types.VmMode(cfgApp.Fixedresources.VirtualizationMode)
 		appInstance.FixedResources.EnableVnc = cfgApp.Fixedresources.EnableVnc
 		appInstance.FixedResources.VncDisplay = cfgApp.Fixedresources.VncDisplay
 		appInstance.FixedResources.VncPasswd = cfgApp.Fixedresources.VncPasswd
+		appInstance.FixedResources.EnableGuestAgent = cfgApp.Fixedresources.EnableGuestAgent
+		appInstance.FixedResources.CPUShares = cfgApp.Fixedresources.CpuShares
+		appInstance.FixedResources.CPUQuota = int(cfgApp.Fixedresources.CpuQuota)
+		appInstance.FixedResources.MemoryHigh = int(cfgApp.Fixedresources.MemoryHigh)
+		appInstance.FixedResources.MemoryMax = int(cfgApp.Fixedresources.MemoryMax)
+		// Out of range is rejected by zedmanager hence don't let it wrap
+		if weight := cfgApp.Fixedresources.BlkioWeight; weight > math.MaxUint16 {
+			appInstance.FixedResources.BlkioWeight = math.MaxUint16
+		} else {
+			appInstance.FixedResources.BlkioWeight = uint16(weight)
+		}
+		appInstance.FixedResources.PidsLimit = cfgApp.Fixedresources.PidsLimit
+		appInstance.FixedResources.EnableVTPM = cfgApp.Fixedresources.EnableVTPM
 
 		appInstance.VolumeRefConfigList = make([]types.VolumeRefConfig,
 			len(cfgApp.VolumeRefList))
@@ -479,6 +495,34 @@ func parseAppInstanceConfig(config *zconfig.EdgeDevConfig,
 		// fill in the collect stats IP address of the App
 		appInstance.CollectStatsIPAddr = net.ParseIP(cfgApp.GetCollectStatsIPAddr())
 
+		probes, err := parseHealthProbes(cfgApp.GetHealthProbes())
+		if err != nil {
+			log.Errorf("App %s: %s", appInstance.DisplayName, err)
+			appInstance.Errors = append(appInstance.Errors, err.Error())
+		}
+		appInstance.HealthProbes = probes
+		appInstance.HealthRemediation = types.HealthRemediation(cfgApp.GetHealthRemediation())
+
+		deps, err := parseAppDependencies(cfgApp.GetDependencies())
+		if err != nil {
+			log.Errorf("App %s: %s", appInstance.DisplayName, err)
+			appInstance.Errors = append(appInstance.Errors, err.Error())
+		}
+		appInstance.Dependencies = deps
+
+		appInstance.SecurityProfile = parseSecurityProfile(cfgApp.GetSecurityProfile())
+		if err := checkSecurityProfile(appInstance.SecurityProfile); err != nil {
+			log.Errorf("App %s: %s", appInstance.DisplayName, err)
+			appInstance.Errors = append(appInstance.Errors, err.Error())
+		}
+
+		pcs, err := parsePodContainers(cfgApp.GetPodContainers())
+		if err != nil {
+			log.Errorf("App %s: %s", appInstance.DisplayName, err)
+			appInstance.Errors = append(appInstance.Errors, err.Error())
+		}
+		appInstance.PodContainers = pcs
+
 		// fill the overlay/underlay config
 		parseAppNetworkConfig(&appInstance, cfgApp, config.Networks,
 			config.NetworkInstances)
@@ -504,6 +548,13 @@ func parseAppInstanceConfig(config *zconfig.EdgeDevConfig,
 			appInstance.PurgeCmd.Counter = cmd.Counter
 			appInstance.PurgeCmd.ApplyTime = cmd.OpsTime
 		}
+		snapshotCmd := cfgApp.GetSnapshot()
+		if snapshotCmd != nil {
+			appInstance.SnapshotCmd.Counter = snapshotCmd.Counter
+			appInstance.SnapshotCmd.Action = types.SnapshotAction(snapshotCmd.Action)
+			appInstance.SnapshotCmd.Name = snapshotCmd.Name
+		}
+		appInstance.RestartPolicy = types.RestartPolicy(cfgApp.GetRestartPolicy())
 		userData := cfgApp.GetUserData()
 		if userData != "" {
 			appInstance.CloudInitUserData = &userData
@@ -846,6 +897,10 @@ func parseDeviceIoListConfig(config *zconfig.EdgeDevConfig,
 				port.Phyaddr.Ioports = value
 			case "usbaddr":
 				port.Phyaddr.UsbAddr = value
+			case "usbproduct":
+				port.Phyaddr.UsbProduct = value
+			case "usbserial":
+				port.Phyaddr.UsbSerial = value
 			default:
 				port.Phyaddr.UnknownType = value
 				log.Warnf("Unrecognized Physical address Ignored: "+
@@ -1003,6 +1058,88 @@ func parseVolumeRefList(volumeRefConfigList []types.VolumeRefConfig,
 	}
 }
 
+// parseHealthProbes skips the probes which can't be run
+func parseHealthProbes(healthProbes []*zconfig.HealthProbe) ([]types.HealthProbe, error) {
+	var probes []types.HealthProbe
+	var errs []string
+	for i, healthProbe := range healthProbes {
+		probe := types.HealthProbe{
+			Type:             types.HealthProbeType(healthProbe.GetType()),
+			Path:             healthProbe.GetPath(),
+			Command:          healthProbe.GetCommand(),
+			InitialDelaySecs: healthProbe.GetInitialDelaySecs(),
+			IntervalSecs:     healthProbe.GetIntervalSecs(),
+			TimeoutSecs:      healthProbe.GetTimeoutSecs(),
+			FailureThreshold: healthProbe.GetFailureThreshold(),
+		}
+		var err error
+		switch probe.Type {
+		case types.HealthProbeHTTP, types.HealthProbeTCP:
+			if healthProbe.GetPort() == 0 || healthProbe.GetPort() > 65535 {
+				err = fmt.Errorf("bad port %d", healthProbe.GetPort())
+			}
+			probe.Port = uint16(healthProbe.GetPort())
+		case types.HealthProbeExec:
+			if len(probe.Command) == 0 {
+				err = fmt.Errorf("no command")
+			}
+		default:
+			err = fmt.Errorf("unsupported type %s", probe.Type)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("health probe %d: %s", i, err))
+			continue
+		}
+		probes = append(probes, probe)
+	}
+	if len(errs) != 0 {
+		return probes, errors.New(strings.Join(errs, "; "))
+	}
+	return probes, nil
+}
+
+// parseAppDependencies skips the dependencies on bad UUIDs
+func parseAppDependencies(appDeps []*zconfig.AppDependency) ([]types.AppDependency, error) {
+	var deps []types.AppDependency
+	var errs []string
+	for _, appDep := range appDeps {
+		id, err := uuid.FromString(appDep.GetAppUuid())
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("dependency on %s: %s",
+				appDep.GetAppUuid(), err))
+			continue
+		}
+		// zedmanager reports unknown types
+		deps = append(deps, types.AppDependency{AppUUID: id,
+			Type: types.AppDependencyType(appDep.GetType())})
+	}
+	if len(errs) != 0 {
+		return deps, errors.New(strings.Join(errs, "; "))
+	}
+	return deps, nil
+}
+
+// parsePodContainers skips the containers with bad volume UUIDs. zedmanager
+// checks the rest against the volumes.
+func parsePodContainers(cfgContainers []*zconfig.PodContainer) ([]types.PodContainer, error) {
+	var pcs []types.PodContainer
+	var errs []string
+	for _, cfgContainer := range cfgContainers {
+		id, err := uuid.FromString(cfgContainer.GetVolumeUuid())
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("pod container %s: %s",
+				cfgContainer.GetName(), err))
+			continue
+		}
+		pcs = append(pcs, types.PodContainer{Name: cfgContainer.GetName(),
+			VolumeID: id})
+	}
+	if len(errs) != 0 {
+		return pcs, errors.New(strings.Join(errs, "; "))
+	}
+	return pcs, nil
+}
+
 // XXX Remove when systemAdapter embeds the NetworkXObject
 func lookupNetworkId(id string, cfgNetworks []*zconfig.NetworkConfig) *zconfig.NetworkConfig {
 	for _, netEnt := range cfgNetworks {
@@ -1351,6 +1488,65 @@ func parseIpspec(ipspec *zconfig.Ipspec,
 	return nil
 }
 
+func parseSecurityProfile(cfgProfile *zconfig.SecurityProfile) types.SecurityProfile {
+	if cfgProfile == nil {
+		return types.SecurityProfile{}
+	}
+	return types.SecurityProfile{
+		Seccomp:         cfgProfile.Seccomp,
+		AppArmor:        cfgProfile.AppArmor,
+		CapAdd:          cfgProfile.CapAdd,
+		CapDrop:         cfgProfile.CapDrop,
+		ReadOnlyRootfs:  cfgProfile.ReadOnlyRootfs,
+		NoNewPrivileges: cfgProfile.NoNewPrivileges,
+		UserNamespace:   cfgProfile.UserNamespace,
+	}
+}
+
+// linuxCapabilities are the capabilities SecurityProfile can add and drop
+var linuxCapabilities = map[string]bool{
+	"CAP_AUDIT_CONTROL": true, "CAP_AUDIT_READ": true, "CAP_AUDIT_WRITE": true,
+	"CAP_BLOCK_SUSPEND": true, "CAP_BPF": true, "CAP_CHECKPOINT_RESTORE": true,
+	"CAP_CHOWN": true, "CAP_DAC_OVERRIDE": true, "CAP_DAC_READ_SEARCH": true,
+	"CAP_FOWNER": true, "CAP_FSETID": true, "CAP_IPC_LOCK": true,
+	"CAP_IPC_OWNER": true, "CAP_KILL": true, "CAP_LEASE": true,
+	"CAP_LINUX_IMMUTABLE": true, "CAP_MAC_ADMIN": true, "CAP_MAC_OVERRIDE": true,
+	"CAP_MKNOD": true, "CAP_NET_ADMIN": true, "CAP_NET_BIND_SERVICE": true,
+	"CAP_NET_BROADCAST": true, "CAP_NET_RAW": true, "CAP_PERFMON": true,
+	"CAP_SETFCAP": true, "CAP_SETGID": true, "CAP_SETPCAP": true,
+	"CAP_SETUID": true, "CAP_SYSLOG": true, "CAP_SYS_ADMIN": true,
+	"CAP_SYS_BOOT": true, "CAP_SYS_CHROOT": true, "CAP_SYS_MODULE": true,
+	"CAP_SYS_NICE": true, "CAP_SYS_PACCT": true, "CAP_SYS_PTRACE": true,
+	"CAP_SYS_RAWIO": true, "CAP_SYS_RESOURCE": true, "CAP_SYS_TIME": true,
+	"CAP_SYS_TTY_CONFIG": true, "CAP_WAKE_ALARM": true,
+}
+
+// checkSecurityProfile returns an error if containerd would not be able to
+// apply the profile
+func checkSecurityProfile(profile types.SecurityProfile) error {
+	if _, err := containerd.ParseSeccomp(profile.Seccomp); err != nil {
+		return err
+	}
+	for _, c := range profile.CapAdd {
+		if !linuxCapabilities[containerd.CapabilityName(c)] {
+			return fmt.Errorf("invalid capability to add: %s", c)
+		}
+	}
+	for _, c := range profile.CapDrop {
+		name := containerd.CapabilityName(c)
+		if name != "ALL" && !linuxCapabilities[name] {
+			return fmt.Errorf("invalid capability to drop: %s", c)
+		}
+	}
+	if strings.ContainsAny(profile.AppArmor, " \t\n/") {
+		return fmt.Errorf("invalid AppArmor profile name: %q", profile.AppArmor)
+	}
+	if profile.UserNamespace {
+		return containerd.ErrUserNamespace
+	}
+	return nil
+}
+
 func parseAppNetworkConfig(appInstance *types.AppInstanceConfig,
 	cfgApp *zconfig.AppInstanceConfig,
 	cfgNetworks []*zconfig.NetworkConfig,
@@ -495,6 +495,8 @@ func PublishDeviceInfoToZedCloud(ctx *zedagentContext) {
 
 	ReportDeviceInfo.Capabilities = getCapabilities(ctx)
 
+	ReportDeviceInfo.ContentEviction = getContentEviction(ctx)
+
 	ReportInfo.InfoContent = new(info.ZInfoMsg_Dinfo)
 	if x, ok := ReportInfo.GetInfoContent().(*info.ZInfoMsg_Dinfo); ok {
 		x.Dinfo = ReportDeviceInfo
@@ -808,3 +810,31 @@ func getCapabilities(ctx *zedagentContext) *info.Capabilities {
 		IOVirtualization:         capabilities.IOVirtualization,
 	}
 }
+
+// getContentEviction returns nil until volumemgr evicted something
+func getContentEviction(ctx *zedagentContext) *info.ContentEvictionInfo {
+	m, err := ctx.subContentEvictionStatus.Get("global")
+	if err != nil {
+		return nil
+	}
+	status := m.(types.ContentEvictionStatus)
+	evictionInfo := &info.ContentEvictionInfo{
+		Evictions:    status.Evictions,
+		EvictedBytes: status.EvictedBytes,
+	}
+	for _, eviction := range status.Recent {
+		lastUse, _ := ptypes.TimestampProto(eviction.LastUse)
+		evictedAt, _ := ptypes.TimestampProto(eviction.EvictedAt)
+		evictionInfo.Recent = append(evictionInfo.Recent,
+			&info.ContentEviction{
+				ContentId:   eviction.ContentID.String(),
+				DisplayName: eviction.DisplayName,
+				RelativeURL: eviction.RelativeURL,
+				Size:        uint64(eviction.Size),
+				LastUse:     lastUse,
+				EvictedAt:   evictedAt,
+				Reason:      eviction.Reason,
+			})
+	}
+	return evictionInfo
+}
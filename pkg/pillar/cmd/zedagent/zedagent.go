@@ -53,6 +53,11 @@ const (
 	// Time limits for event loop handlers
 	errorTime   = 3 * time.Minute
 	warningTime = 40 * time.Second
+	// Metrics are only reported every minute hence we only need the
+	// latest one per key; queue limits for the high-churn subscriptions
+	metricsCoalesceWindow = 5 * time.Second
+	metricsMaxQueueLength = 256
+	flowMaxQueueLength    = 1024
 )
 
 // Set from Makefile
@@ -107,9 +112,11 @@ type zedagentContext struct {
 	GCInitialized             bool // Received initial GlobalConfig
 	subZbootStatus            pubsub.Subscription
 	subAppContainerMetrics    pubsub.Subscription
+	subTaskContainerMetrics   pubsub.Subscription
 	subDiskMetric             pubsub.Subscription
 	subAppDiskMetric          pubsub.Subscription
 	subCapabilities           pubsub.Subscription
+	subContentEvictionStatus  pubsub.Subscription
 	rebootCmd                 bool
 	rebootCmdDeferred         bool
 	deviceReboot              bool
@@ -517,6 +524,10 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 		DeleteHandler: handleAppFlowMonitorDelete,
 		WarningTime:   warningTime,
 		ErrorTime:     errorTime,
+		// Each flow record is a new key hence nothing to merge and
+		// nothing we want to drop
+		MaxQueueLength: flowMaxQueueLength,
+		QueuePolicy:    pubsub.QueueMerge,
 	})
 	if err != nil {
 		log.Fatal(err)
@@ -592,6 +603,10 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 		Ctx:         &zedagentCtx,
 		WarningTime: warningTime,
 		ErrorTime:   errorTime,
+		// Only the latest metrics for each domain are used
+		CoalesceWindow: metricsCoalesceWindow,
+		MaxQueueLength: metricsMaxQueueLength,
+		QueuePolicy:    pubsub.QueueDropOldest,
 	})
 	if err != nil {
 		log.Fatal(err)
@@ -665,6 +680,24 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	zedagentCtx.subAppContainerMetrics = subAppContainerMetrics
 	subAppContainerMetrics.Activate()
 
+	// sub AppContainerMetrics of the containerd tasks from domainmgr
+	subTaskContainerMetrics, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName:     "domainmgr",
+		MyAgentName:   agentName,
+		TopicImpl:     types.AppContainerMetrics{},
+		Activate:      false,
+		Ctx:           &zedagentCtx,
+		CreateHandler: handleAppContainerMetricsCreate,
+		ModifyHandler: handleAppContainerMetricsModify,
+		WarningTime:   warningTime,
+		ErrorTime:     errorTime,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	zedagentCtx.subTaskContainerMetrics = subTaskContainerMetrics
+	subTaskContainerMetrics.Activate()
+
 	subBaseOsStatus, err := ps.NewSubscription(pubsub.SubscriptionOptions{
 		AgentName:     "baseosmgr",
 		MyAgentName:   agentName,
@@ -852,6 +885,10 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 		DeleteHandler: handleDiskMetricDelete,
 		WarningTime:   warningTime,
 		ErrorTime:     errorTime,
+		// Only the latest metrics for each disk are used
+		CoalesceWindow: metricsCoalesceWindow,
+		MaxQueueLength: metricsMaxQueueLength,
+		QueuePolicy:    pubsub.QueueDropOldest,
 	})
 	if err != nil {
 		log.Fatal(err)
@@ -889,6 +926,23 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	zedagentCtx.subCapabilities = subCapabilities
 	subCapabilities.Activate()
 
+	subContentEvictionStatus, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName:     "volumemgr",
+		MyAgentName:   agentName,
+		TopicImpl:     types.ContentEvictionStatus{},
+		Activate:      false,
+		Ctx:           &zedagentCtx,
+		CreateHandler: handleContentEvictionStatusCreate,
+		ModifyHandler: handleContentEvictionStatusModify,
+		WarningTime:   warningTime,
+		ErrorTime:     errorTime,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	zedagentCtx.subContentEvictionStatus = subContentEvictionStatus
+	subContentEvictionStatus.Activate()
+
 	//initialize cipher processing block
 	cipherModuleInitialize(&zedagentCtx, ps)
 
@@ -1258,6 +1312,9 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 		case change := <-subAppContainerMetrics.MsgChan():
 			subAppContainerMetrics.ProcessChange(change)
 
+		case change := <-subTaskContainerMetrics.MsgChan():
+			subTaskContainerMetrics.ProcessChange(change)
+
 		case change := <-subDiskMetric.MsgChan():
 			subDiskMetric.ProcessChange(change)
 
@@ -1267,6 +1324,9 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 		case change := <-subCapabilities.MsgChan():
 			subCapabilities.ProcessChange(change)
 
+		case change := <-subContentEvictionStatus.MsgChan():
+			subContentEvictionStatus.ProcessChange(change)
+
 		case <-stillRunning.C:
 			// Fault injection
 			if fatalFlag {
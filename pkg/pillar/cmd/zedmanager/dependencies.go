@@ -0,0 +1,283 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Order the activation and halting of app instances based on the
+// Dependencies in their AppInstanceConfig. The dependencies form a DAG;
+// a cycle is reported as an error on every app instance on it.
+
+package zedmanager
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+type appConfigs map[string]types.AppInstanceConfig
+type appStatuses map[string]types.AppInstanceStatus
+
+func getAppConfigs(ctx *zedmanagerContext) appConfigs {
+	configs := make(appConfigs)
+	for key, c := range ctx.subAppInstanceConfig.GetAll() {
+		configs[key] = c.(types.AppInstanceConfig)
+	}
+	return configs
+}
+
+func getAppStatuses(ctx *zedmanagerContext) appStatuses {
+	statuses := make(appStatuses)
+	for key, st := range ctx.pubAppInstanceStatus.GetAll() {
+		statuses[key] = st.(types.AppInstanceStatus)
+	}
+	return statuses
+}
+
+func (configs appConfigs) name(key string) string {
+	if config, ok := configs[key]; ok && config.DisplayName != "" {
+		return config.DisplayName
+	}
+	return key
+}
+
+// dependencyCycle returns the keys of the app instances on a cycle through
+// key, starting and ending with key, or nil if there is no such cycle
+func dependencyCycle(configs appConfigs, key string) []string {
+	visited := make(map[string]bool)
+	var visit func(from string, path []string) []string
+	visit = func(from string, path []string) []string {
+		for _, dep := range configs[from].Dependencies {
+			to := dep.AppUUID.String()
+			if to == key {
+				return append(path, to)
+			}
+			if _, ok := configs[to]; !ok || visited[to] {
+				continue
+			}
+			visited[to] = true
+			if cycle := visit(to, append(path, to)); cycle != nil {
+				return cycle
+			}
+		}
+		return nil
+	}
+	return visit(key, []string{key})
+}
+
+// haltPending returns true if the app instance is halted or going to be
+// halted; either by its config or by one of its stop-before dependencies.
+// The dependencies might have a cycle of their own, which is reported on
+// the app instances on it, hence each app instance is only visited once.
+func haltPending(configs appConfigs, key string) bool {
+	visited := make(map[string]bool)
+	var visit func(key string) bool
+	visit = func(key string) bool {
+		if visited[key] {
+			return false
+		}
+		visited[key] = true
+		config, ok := configs[key]
+		if !ok || !config.Activate {
+			return true
+		}
+		for _, dep := range config.Dependencies {
+			if dep.Type == types.AppDependencyStopBefore &&
+				visit(dep.AppUUID.String()) {
+				return true
+			}
+		}
+		return false
+	}
+	return visit(key)
+}
+
+// evalDependencies checks the dependencies of an app instance. It returns
+// what the app waits for before it can be activated, whether it needs to be
+// halted, and an error if a dependency can never be satisfied.
+func evalDependencies(configs appConfigs, statuses appStatuses,
+	config types.AppInstanceConfig) (string, bool, error) {
+
+	if len(config.Dependencies) == 0 {
+		return "", false, nil
+	}
+	if cycle := dependencyCycle(configs, config.Key()); cycle != nil {
+		var names []string
+		for _, key := range cycle {
+			names = append(names, configs.name(key))
+		}
+		return "", false, fmt.Errorf("dependency cycle: %s",
+			strings.Join(names, " -> "))
+	}
+	var waits []string
+	halt := false
+	for _, dep := range config.Dependencies {
+		key := dep.AppUUID.String()
+		name := configs.name(key)
+		depConfig, ok := configs[key]
+		if !ok {
+			return "", dep.Type == types.AppDependencyStopBefore,
+				fmt.Errorf("%s dependency on app %s which is not configured",
+					dep.Type, name)
+		}
+		switch dep.Type {
+		case types.AppDependencyStartAfter, types.AppDependencyWaitHealthy:
+			if !depConfig.Activate {
+				return "", false,
+					fmt.Errorf("%s dependency on app %s which is not activated",
+						dep.Type, name)
+			}
+			if dep.Type == types.AppDependencyWaitHealthy &&
+				len(depConfig.HealthProbes) == 0 {
+				return "", false,
+					fmt.Errorf("%s dependency on app %s which has no health probes",
+						dep.Type, name)
+			}
+			depStatus, ok := statuses[key]
+			if !ok || !depStatus.Activated || depStatus.State != types.RUNNING {
+				waits = append(waits, fmt.Sprintf("app %s to be running", name))
+			} else if dep.Type == types.AppDependencyWaitHealthy &&
+				depStatus.Health != types.HealthHealthy {
+				waits = append(waits, fmt.Sprintf("app %s to be healthy", name))
+			}
+		case types.AppDependencyStopBefore:
+			if haltPending(configs, key) {
+				waits = append(waits, fmt.Sprintf("app %s to be activated", name))
+				halt = true
+			}
+		default:
+			return "", false, fmt.Errorf("%s on app %s", dep.Type, name)
+		}
+	}
+	if len(waits) == 0 {
+		return "", false, nil
+	}
+	return "Waiting for " + strings.Join(waits, ", "), halt, nil
+}
+
+// stopDependentsWait returns what halting the app instance with key waits
+// for, which is all the app instances with a stop-before dependency on it
+// to be halted
+func stopDependentsWait(configs appConfigs, statuses appStatuses,
+	key string) string {
+
+	var waits []string
+	for otherKey, config := range configs {
+		for _, dep := range config.Dependencies {
+			if dep.Type != types.AppDependencyStopBefore ||
+				dep.AppUUID.String() != key {
+				continue
+			}
+			status, ok := statuses[otherKey]
+			if ok && (status.Activated || status.ActivateInprogress) {
+				waits = append(waits, fmt.Sprintf("app %s to halt",
+					configs.name(otherKey)))
+			}
+			break
+		}
+	}
+	if len(waits) == 0 {
+		return ""
+	}
+	return "Waiting for " + strings.Join(waits, ", ")
+}
+
+// dependenciesReady updates the DependencyWait and the dependency error of
+// the app instance and returns whether it may be activated or stay active.
+// Returns changed, ready.
+func dependenciesReady(ctx *zedmanagerContext, config types.AppInstanceConfig,
+	status *types.AppInstanceStatus) (bool, bool) {
+
+	changed := false
+	wait, halt, err := evalDependencies(getAppConfigs(ctx),
+		getAppStatuses(ctx), config)
+	if err != nil {
+		if status.Error != err.Error() {
+			log.Errorf("dependenciesReady(%s): %s", status.Key(), err)
+			status.SetErrorWithSource(err.Error(), types.AppDependency{},
+				time.Now())
+			changed = true
+		}
+	} else if status.IsErrorSource(types.AppDependency{}) {
+		log.Functionf("Clearing dependency error %s", status.Error)
+		status.ClearErrorWithSource()
+		changed = true
+	}
+	if status.DependencyWait != wait {
+		log.Functionf("dependenciesReady(%s) from %q to %q",
+			status.Key(), status.DependencyWait, wait)
+		status.DependencyWait = wait
+		changed = true
+	}
+	// Dependencies only hold back the start of an app unless they are of
+	// the stop-before kind
+	if halt {
+		return changed, false
+	}
+	if status.Activated {
+		return changed, true
+	}
+	return changed, wait == "" && err == nil
+}
+
+// haltReady updates the DependencyWait of an app instance which is about to
+// be halted or deleted, and returns whether that may proceed.
+// Returns changed, ready.
+func haltReady(ctx *zedmanagerContext, status *types.AppInstanceStatus) (bool, bool) {
+	wait := ""
+	if status.Activated || status.ActivateInprogress {
+		wait = stopDependentsWait(getAppConfigs(ctx), getAppStatuses(ctx),
+			status.Key())
+	}
+	if status.DependencyWait == wait {
+		return false, wait == ""
+	}
+	log.Functionf("haltReady(%s) from %q to %q",
+		status.Key(), status.DependencyWait, wait)
+	status.DependencyWait = wait
+	return true, wait == ""
+}
+
+// dependencyStateChanged returns true if the app instance changed in a way
+// which can matter for the other app instances it is related to
+func dependencyStateChanged(old interface{}, status types.AppInstanceStatus) bool {
+	if old == nil {
+		return true
+	}
+	oldStatus := old.(types.AppInstanceStatus)
+	return oldStatus.Activated != status.Activated ||
+		oldStatus.ActivateInprogress != status.ActivateInprogress ||
+		oldStatus.State != status.State ||
+		oldStatus.Health != status.Health ||
+		oldStatus.DependencyWait != status.DependencyWait
+}
+
+// updateRelatedApps queues the re-evaluation of the app instances which
+// depend on the one with key or which it depends on. It is called while
+// publishing, i.e., in the middle of updating an app instance, hence the
+// updates are left to processDependencyUpdates.
+func updateRelatedApps(ctx *zedmanagerContext, key string) {
+	for otherKey, config := range getAppConfigs(ctx) {
+		for _, dep := range config.Dependencies {
+			if otherKey == key {
+				ctx.pendingDependencyUpdates[dep.AppUUID.String()] = true
+			} else if dep.AppUUID.String() == key {
+				ctx.pendingDependencyUpdates[otherKey] = true
+			}
+		}
+	}
+}
+
+// processDependencyUpdates runs the updates queued by updateRelatedApps.
+// Called from the main loop once the handler which queued them returned.
+// The updates can cascade by queueing more.
+func processDependencyUpdates(ctx *zedmanagerContext) {
+	for len(ctx.pendingDependencyUpdates) != 0 {
+		for key := range ctx.pendingDependencyUpdates {
+			delete(ctx.pendingDependencyUpdates, key)
+			log.Functionf("processDependencyUpdates updating %s", key)
+			updateAIStatusUUID(ctx, key)
+			break
+		}
+	}
+}
@@ -0,0 +1,216 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedmanager
+
+import (
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	uuid "github.com/satori/go.uuid"
+)
+
+func testAppConfig(id uuid.UUID, name string, activate bool,
+	deps ...types.AppDependency) types.AppInstanceConfig {
+
+	return types.AppInstanceConfig{
+		UUIDandVersion: types.UUIDandVersion{UUID: id},
+		DisplayName:    name,
+		Activate:       activate,
+		Dependencies:   deps,
+	}
+}
+
+func TestEvalDependencies(t *testing.T) {
+	broker := uuid.NewV4()
+	db := uuid.NewV4()
+	consumer := uuid.NewV4()
+	missing := uuid.NewV4()
+
+	startAfter := func(id uuid.UUID) types.AppDependency {
+		return types.AppDependency{AppUUID: id, Type: types.AppDependencyStartAfter}
+	}
+	waitHealthy := func(id uuid.UUID) types.AppDependency {
+		return types.AppDependency{AppUUID: id, Type: types.AppDependencyWaitHealthy}
+	}
+	stopBefore := func(id uuid.UUID) types.AppDependency {
+		return types.AppDependency{AppUUID: id, Type: types.AppDependencyStopBefore}
+	}
+	running := types.AppInstanceStatus{Activated: true, State: types.RUNNING}
+	healthy := running
+	healthy.Health = types.HealthHealthy
+
+	testMatrix := map[string]struct {
+		configs  []types.AppInstanceConfig
+		statuses map[uuid.UUID]types.AppInstanceStatus
+		waiting  bool
+		halt     bool
+		fail     bool
+	}{
+		"no dependencies": {
+			configs: []types.AppInstanceConfig{testAppConfig(consumer, "consumer", true)},
+		},
+		"start after running": {
+			configs: []types.AppInstanceConfig{
+				testAppConfig(consumer, "consumer", true, startAfter(broker)),
+				testAppConfig(broker, "broker", true),
+			},
+			statuses: map[uuid.UUID]types.AppInstanceStatus{broker: running},
+		},
+		"start after booting": {
+			configs: []types.AppInstanceConfig{
+				testAppConfig(consumer, "consumer", true, startAfter(broker)),
+				testAppConfig(broker, "broker", true),
+			},
+			waiting: true,
+		},
+		"start after not activated": {
+			configs: []types.AppInstanceConfig{
+				testAppConfig(consumer, "consumer", true, startAfter(broker)),
+				testAppConfig(broker, "broker", false),
+			},
+			fail: true,
+		},
+		"start after missing": {
+			configs: []types.AppInstanceConfig{
+				testAppConfig(consumer, "consumer", true, startAfter(missing)),
+			},
+			fail: true,
+		},
+		"wait healthy running": {
+			configs: []types.AppInstanceConfig{
+				testAppConfig(consumer, "consumer", true, waitHealthy(db)),
+				{
+					UUIDandVersion: types.UUIDandVersion{UUID: db},
+					Activate:       true,
+					HealthProbes:   []types.HealthProbe{{Type: types.HealthProbeTCP}},
+				},
+			},
+			statuses: map[uuid.UUID]types.AppInstanceStatus{db: running},
+			waiting:  true,
+		},
+		"wait healthy healthy": {
+			configs: []types.AppInstanceConfig{
+				testAppConfig(consumer, "consumer", true, waitHealthy(db)),
+				{
+					UUIDandVersion: types.UUIDandVersion{UUID: db},
+					Activate:       true,
+					HealthProbes:   []types.HealthProbe{{Type: types.HealthProbeTCP}},
+				},
+			},
+			statuses: map[uuid.UUID]types.AppInstanceStatus{db: healthy},
+		},
+		"wait healthy without probes": {
+			configs: []types.AppInstanceConfig{
+				testAppConfig(consumer, "consumer", true, waitHealthy(db)),
+				testAppConfig(db, "db", true),
+			},
+			fail: true,
+		},
+		"stop before activated": {
+			configs: []types.AppInstanceConfig{
+				testAppConfig(consumer, "consumer", true, stopBefore(broker)),
+				testAppConfig(broker, "broker", true),
+			},
+		},
+		"stop before halting": {
+			configs: []types.AppInstanceConfig{
+				testAppConfig(consumer, "consumer", true, stopBefore(broker)),
+				testAppConfig(broker, "broker", false),
+			},
+			waiting: true,
+			halt:    true,
+		},
+		"stop before halting transitively": {
+			configs: []types.AppInstanceConfig{
+				testAppConfig(consumer, "consumer", true, stopBefore(broker)),
+				testAppConfig(broker, "broker", true, stopBefore(db)),
+				testAppConfig(db, "db", false),
+			},
+			waiting: true,
+			halt:    true,
+		},
+		"stop before missing": {
+			configs: []types.AppInstanceConfig{
+				testAppConfig(consumer, "consumer", true, stopBefore(missing)),
+			},
+			halt: true,
+			fail: true,
+		},
+		"self": {
+			configs: []types.AppInstanceConfig{
+				testAppConfig(consumer, "consumer", true, startAfter(consumer)),
+			},
+			fail: true,
+		},
+		"cycle": {
+			configs: []types.AppInstanceConfig{
+				testAppConfig(consumer, "consumer", true, startAfter(broker)),
+				testAppConfig(broker, "broker", true, startAfter(db)),
+				testAppConfig(db, "db", true, stopBefore(consumer)),
+			},
+			fail: true,
+		},
+		"stop before a cycle of others": {
+			configs: []types.AppInstanceConfig{
+				testAppConfig(consumer, "consumer", true, stopBefore(broker)),
+				testAppConfig(broker, "broker", true, stopBefore(db)),
+				testAppConfig(db, "db", true, stopBefore(broker)),
+			},
+		},
+		"stop before a cycle of others halting": {
+			configs: []types.AppInstanceConfig{
+				testAppConfig(consumer, "consumer", true, stopBefore(broker)),
+				testAppConfig(broker, "broker", true, stopBefore(db)),
+				testAppConfig(db, "db", true, stopBefore(broker), stopBefore(missing)),
+			},
+			waiting: true,
+			halt:    true,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		configs := make(appConfigs)
+		for _, config := range test.configs {
+			configs[config.Key()] = config
+		}
+		statuses := make(appStatuses)
+		for id, status := range test.statuses {
+			statuses[id.String()] = status
+		}
+		wait, halt, err := evalDependencies(configs, statuses, test.configs[0])
+		if (err != nil) != test.fail {
+			t.Errorf("%s: unexpected error %v", testname, err)
+		}
+		if (wait != "") != test.waiting {
+			t.Errorf("%s: unexpected wait %q", testname, wait)
+		}
+		if halt != test.halt {
+			t.Errorf("%s: halt %t, expected %t", testname, halt, test.halt)
+		}
+	}
+}
+
+func TestStopDependentsWait(t *testing.T) {
+	broker := uuid.NewV4()
+	consumer := uuid.NewV4()
+	other := uuid.NewV4()
+	configs := appConfigs{
+		broker.String(): testAppConfig(broker, "broker", false),
+		consumer.String(): testAppConfig(consumer, "consumer", true,
+			types.AppDependency{AppUUID: broker, Type: types.AppDependencyStopBefore}),
+		other.String(): testAppConfig(other, "other", true,
+			types.AppDependency{AppUUID: broker, Type: types.AppDependencyStartAfter}),
+	}
+	statuses := appStatuses{
+		consumer.String(): {Activated: true},
+		other.String():    {Activated: true},
+	}
+	if wait := stopDependentsWait(configs, statuses, broker.String()); wait != "Waiting for app consumer to halt" {
+		t.Errorf("unexpected wait %q", wait)
+	}
+	statuses[consumer.String()] = types.AppInstanceStatus{}
+	if wait := stopDependentsWait(configs, statuses, broker.String()); wait != "" {
+		t.Errorf("unexpected wait %q", wait)
+	}
+}
@@ -46,6 +46,16 @@ func MaybeAddDomainConfig(ctx *zedmanagerContext,
 		CloudInitUserData: aiConfig.CloudInitUserData,
 		CipherBlockStatus: aiConfig.CipherBlockStatus,
 		GPUConfig:         "legacy",
+		SecurityProfile:   aiConfig.SecurityProfile,
+		SnapshotCmd:       aiConfig.SnapshotCmd,
+	}
+	dc.RestartPolicy = aiConfig.RestartPolicy
+	if dc.RestartPolicy == types.RestartPolicyDefault {
+		// The controller left it to the global setting
+		if policy, err := types.ParseRestartPolicy(
+			ctx.globalConfig.GlobalValueString(types.AppRestartPolicy)); err == nil {
+			dc.RestartPolicy = policy
+		}
 	}
 
 	dc.DiskConfigList = make([]types.DiskConfig, 0, len(aiStatus.VolumeRefStatusList))
@@ -69,6 +79,11 @@ func MaybeAddDomainConfig(ctx *zedmanagerContext,
 		disk.Format = vrs.ContentFormat
 		disk.MountDir = vrs.MountDir
 		disk.DisplayName = vrs.DisplayName
+		for _, pc := range aiConfig.PodContainers {
+			if pc.VolumeID == vrc.VolumeID {
+				disk.PodContainer = pc.Name
+			}
+		}
 		dc.DiskConfigList = append(dc.DiskConfigList, disk)
 	}
 	// let's fill some of the default values (arguably we may want controller
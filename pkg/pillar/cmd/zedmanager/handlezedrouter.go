@@ -22,7 +22,12 @@ func MaybeAddAppNetworkConfig(ctx *zedmanagerContext,
 	m := lookupAppNetworkConfig(ctx, key)
 	if m != nil {
 		log.Functionf("appNetwork config already exists for %s", key)
-		if len(aiConfig.UnderlayNetworkList) != len(m.UnderlayNetworkList) {
+		if len(aiConfig.UnderlayNetworkList) > len(m.UnderlayNetworkList) &&
+			hotplugCapable(aiConfig, *aiStatus) {
+			log.Functionf("MaybeAddAppNetworkConfig underlays added from %d to %d",
+				len(m.UnderlayNetworkList), len(aiConfig.UnderlayNetworkList))
+			changed = true
+		} else if len(aiConfig.UnderlayNetworkList) != len(m.UnderlayNetworkList) {
 			log.Errorln("Unsupported: Changed number of underlays for ",
 				aiConfig.UUIDandVersion)
 			return
@@ -37,8 +42,8 @@ func MaybeAddAppNetworkConfig(ctx *zedmanagerContext,
 				m.GetStatsIPAddr.String(), aiConfig.CollectStatsIPAddr.String())
 			changed = true
 		}
-		for i, new := range aiConfig.UnderlayNetworkList {
-			old := m.UnderlayNetworkList[i]
+		for i, old := range m.UnderlayNetworkList {
+			new := aiConfig.UnderlayNetworkList[i]
 			if !reflect.DeepEqual(new.ACLs, old.ACLs) {
 				log.Functionf("Under ACLs changed from %v to %v",
 					old.ACLs, new.ACLs)
@@ -0,0 +1,311 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Run the HealthProbes of the apps and remediate the unhealthy ones
+
+package zedmanager
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/lf-edge/eve/pkg/pillar/containerd"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+const (
+	defaultHealthInterval  = 10 * time.Second
+	defaultHealthTimeout   = 5 * time.Second
+	defaultHealthThreshold = 3
+)
+
+// healthTarget is what the probes need to know about the app; updated
+// from the main loop each time we publish the AppInstanceStatus
+type healthTarget struct {
+	running    bool
+	bootTime   time.Time
+	ipAddr     string
+	domainName string
+	container  bool // Exec probes only work for plain containers
+}
+
+// healthResult is the outcome of one check of probe index of an app
+type healthResult struct {
+	key   string
+	index int
+	time  time.Time
+	err   error
+}
+
+// healthChecker runs one goroutine per probe of an app. All but the
+// target is only used from the main loop.
+type healthChecker struct {
+	probes   []types.HealthProbe
+	failures []uint32 // Consecutive failures of each probe
+	passed   []bool   // Last check of each probe since boot passed
+	stop     chan struct{}
+
+	sync.Mutex
+	target healthTarget
+}
+
+func (checker *healthChecker) setTarget(target healthTarget) {
+	checker.Lock()
+	defer checker.Unlock()
+	checker.target = target
+}
+
+func (checker *healthChecker) getTarget() healthTarget {
+	checker.Lock()
+	defer checker.Unlock()
+	return checker.target
+}
+
+func secsOrDefault(secs uint32, def time.Duration) time.Duration {
+	if secs == 0 {
+		return def
+	}
+	return time.Duration(secs) * time.Second
+}
+
+func thresholdOrDefault(probe types.HealthProbe) uint32 {
+	if probe.FailureThreshold == 0 {
+		return defaultHealthThreshold
+	}
+	return probe.FailureThreshold
+}
+
+// runProbe checks the app once; in a goroutine of the healthChecker
+func (checker *healthChecker) runProbe(key string, index int,
+	results chan<- healthResult) {
+
+	probe := checker.probes[index]
+	ticker := time.NewTicker(secsOrDefault(probe.IntervalSecs, defaultHealthInterval))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-checker.stop:
+			return
+		case <-ticker.C:
+		}
+		target := checker.getTarget()
+		if !target.running ||
+			time.Since(target.bootTime) < time.Duration(probe.InitialDelaySecs)*time.Second {
+			continue
+		}
+		err := checkHealth(probe, target)
+		select {
+		case <-checker.stop:
+			return
+		case results <- healthResult{key: key, index: index, time: time.Now(), err: err}:
+		}
+	}
+}
+
+// checkHealth runs a single check of the probe against the app
+func checkHealth(probe types.HealthProbe, target healthTarget) error {
+	timeout := secsOrDefault(probe.TimeoutSecs, defaultHealthTimeout)
+	switch probe.Type {
+	case types.HealthProbeHTTP, types.HealthProbeTCP:
+		if target.ipAddr == "" {
+			return fmt.Errorf("%s probe: no IP address for the app", probe.Type)
+		}
+		addr := net.JoinHostPort(target.ipAddr, strconv.Itoa(int(probe.Port)))
+		if probe.Type == types.HealthProbeTCP {
+			conn, err := net.DialTimeout("tcp", addr, timeout)
+			if err != nil {
+				return fmt.Errorf("tcp probe: %v", err)
+			}
+			conn.Close()
+			return nil
+		}
+		client := http.Client{Timeout: timeout}
+		resp, err := client.Get(fmt.Sprintf("http://%s%s", addr, probe.Path))
+		if err != nil {
+			return fmt.Errorf("http probe: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+			return fmt.Errorf("http probe: GET %s returned %s", probe.Path, resp.Status)
+		}
+		return nil
+	case types.HealthProbeExec:
+		if !target.container {
+			return fmt.Errorf("exec probe: only supported for container apps")
+		}
+		client, err := getCtrdClient()
+		if err != nil {
+			return fmt.Errorf("exec probe: %v", err)
+		}
+		ctrdCtx, done := client.CtrNewUserServicesCtx()
+		defer done()
+		ctrdCtx, cancel := context.WithTimeout(ctrdCtx, timeout)
+		defer cancel()
+		if _, stdErr, err := client.CtrExec(ctrdCtx, target.domainName, probe.Command); err != nil {
+			return fmt.Errorf("exec probe: %v %s", err, stdErr)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported health probe type %s", probe.Type)
+	}
+}
+
+var ctrdClient struct {
+	sync.Mutex
+	client *containerd.Client
+}
+
+// getCtrdClient connects to containerd the first time an exec probe runs
+func getCtrdClient() (*containerd.Client, error) {
+	ctrdClient.Lock()
+	defer ctrdClient.Unlock()
+	if ctrdClient.client == nil {
+		client, err := containerd.NewContainerdClient()
+		if err != nil {
+			return nil, err
+		}
+		ctrdClient.client = client
+	}
+	return ctrdClient.client, nil
+}
+
+// updateHealthChecker starts, restarts or stops the probes of the app
+// based on its config and tells them about its current state
+func updateHealthChecker(ctx *zedmanagerContext, status *types.AppInstanceStatus) {
+	key := status.Key()
+	var probes []types.HealthProbe
+	if config := lookupAppInstanceConfig(ctx, key); config != nil {
+		probes = config.HealthProbes
+	}
+	checker := ctx.healthCheckers[key]
+	if checker != nil && !cmp.Equal(checker.probes, probes) {
+		stopHealthChecker(ctx, key)
+		checker = nil
+	}
+	if len(probes) == 0 {
+		return
+	}
+	target := healthTarget{
+		running:    status.Activated && status.State == types.RUNNING,
+		bootTime:   status.BootTime,
+		domainName: status.DomainName,
+	}
+	for _, ulStatus := range status.UnderlayNetworks {
+		if ulStatus.AllocatedIPAddr != "" {
+			target.ipAddr = ulStatus.AllocatedIPAddr
+			break
+		}
+	}
+	if ds := lookupDomainStatus(ctx, key); ds != nil {
+		target.container = ds.VirtualizationMode == types.NOHYPER
+	}
+	if checker == nil {
+		log.Functionf("updateHealthChecker(%s) starting %d probes", key, len(probes))
+		checker = &healthChecker{
+			probes:   probes,
+			failures: make([]uint32, len(probes)),
+			passed:   make([]bool, len(probes)),
+			stop:     make(chan struct{}),
+			target:   target,
+		}
+		ctx.healthCheckers[key] = checker
+		for i := range probes {
+			go checker.runProbe(key, i, ctx.healthResults)
+		}
+		return
+	}
+	if !target.running || !target.bootTime.Equal(checker.getTarget().bootTime) {
+		// Start over for the next boot
+		for i := range checker.probes {
+			checker.failures[i] = 0
+			checker.passed[i] = false
+		}
+		status.Health = types.HealthUnknown
+	}
+	checker.setTarget(target)
+}
+
+func stopHealthChecker(ctx *zedmanagerContext, key string) {
+	checker := ctx.healthCheckers[key]
+	if checker == nil {
+		return
+	}
+	log.Functionf("stopHealthChecker(%s)", key)
+	close(checker.stop)
+	delete(ctx.healthCheckers, key)
+}
+
+// handleHealthResult updates the health of the app with the result of one
+// of its probes and applies the remediation once the app turns UNHEALTHY
+func handleHealthResult(ctx *zedmanagerContext, result healthResult) {
+	checker := ctx.healthCheckers[result.key]
+	status := lookupAppInstanceStatus(ctx, result.key)
+	config := lookupAppInstanceConfig(ctx, result.key)
+	if checker == nil || status == nil || config == nil ||
+		result.index >= len(checker.probes) {
+		return
+	}
+	if !checker.getTarget().running {
+		// Stale result from before the app stopped
+		return
+	}
+	status.LastHealthCheck = result.time
+	if result.err != nil {
+		checker.failures[result.index]++
+		checker.passed[result.index] = false
+		status.LastHealthError = result.err.Error()
+		log.Warnf("handleHealthResult(%s) probe %d failed %d times: %s",
+			result.key, result.index, checker.failures[result.index], result.err)
+	} else {
+		checker.failures[result.index] = 0
+		checker.passed[result.index] = true
+	}
+
+	health := types.HealthHealthy
+	for i, probe := range checker.probes {
+		if checker.failures[i] >= thresholdOrDefault(probe) {
+			health = types.HealthUnhealthy
+			break
+		}
+		if !checker.passed[i] {
+			health = types.HealthUnknown
+		}
+	}
+	if health != status.Health {
+		log.Noticef("handleHealthResult(%s) health from %s to %s",
+			result.key, status.Health, health)
+	}
+	becameUnhealthy := health == types.HealthUnhealthy &&
+		status.Health != types.HealthUnhealthy
+	status.Health = health
+	if becameUnhealthy && config.HealthRemediation != types.HealthRemediationNone &&
+		status.RestartInprogress == types.NotInprogress &&
+		status.PurgeInprogress == types.NotInprogress {
+		remediateHealth(ctx, *config, status)
+	}
+	publishAppInstanceStatus(ctx, status)
+}
+
+// remediateHealth restarts the app like a RestartCmd from the controller
+// would. There is no purge since only the controller can give the volumes
+// a new generation.
+func remediateHealth(ctx *zedmanagerContext, config types.AppInstanceConfig,
+	status *types.AppInstanceStatus) {
+
+	log.Noticef("remediateHealth(%s) %s after %s",
+		status.Key(), config.HealthRemediation, status.LastHealthError)
+	status.HealthRemediated++
+	switch config.HealthRemediation {
+	case types.HealthRemediationRestart:
+		status.RestartInprogress = types.BringDown
+		status.State = types.RESTARTING
+	}
+	publishAppInstanceStatus(ctx, status)
+	doUpdate(ctx, config, status)
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedmanager
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+func TestCheckHealth(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/healthz" {
+			http.Error(w, "not found", http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+	host, portStr, err := net.SplitHostPort(srv.Listener.Addr().String())
+	if err != nil {
+		t.Fatalf("can't parse server address %v", err)
+	}
+	port, _ := strconv.Atoi(portStr)
+	target := healthTarget{running: true, ipAddr: host}
+
+	testMatrix := map[string]struct {
+		probe  types.HealthProbe
+		target healthTarget
+		fail   bool
+	}{
+		"http":         {probe: types.HealthProbe{Type: types.HealthProbeHTTP, Port: uint16(port), Path: "/healthz"}, target: target},
+		"http 404":     {probe: types.HealthProbe{Type: types.HealthProbeHTTP, Port: uint16(port), Path: "/missing"}, target: target, fail: true},
+		"tcp":          {probe: types.HealthProbe{Type: types.HealthProbeTCP, Port: uint16(port)}, target: target},
+		"no address":   {probe: types.HealthProbe{Type: types.HealthProbeTCP, Port: uint16(port)}, target: healthTarget{running: true}, fail: true},
+		"exec on a vm": {probe: types.HealthProbe{Type: types.HealthProbeExec, Command: []string{"true"}}, target: target, fail: true},
+		"none":         {probe: types.HealthProbe{}, target: target, fail: true},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		err := checkHealth(test.probe, test.target)
+		if test.fail && err == nil {
+			t.Errorf("checkHealth(%s) should've failed", testname)
+		} else if !test.fail && err != nil {
+			t.Errorf("checkHealth(%s) failed: %v", testname, err)
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/lf-edge/eve/pkg/pillar/types"
 	"github.com/lf-edge/eve/pkg/pillar/uuidtonum"
 	"github.com/satori/go.uuid"
@@ -53,6 +54,16 @@ func removeAIStatusUUID(ctx *zedmanagerContext, uuidStr string) {
 func removeAIStatus(ctx *zedmanagerContext, status *types.AppInstanceStatus) {
 	uuidStr := status.Key()
 	uninstall := (status.PurgeInprogress != types.BringDown)
+	if uninstall {
+		if changed, ready := haltReady(ctx, status); !ready {
+			log.Functionf("removeAIStatus(%s) waiting for dependents to halt",
+				uuidStr)
+			if changed {
+				publishAppInstanceStatus(ctx, status)
+			}
+			return
+		}
+	}
 	changed, done := doRemove(ctx, status, uninstall)
 	if changed {
 		log.Functionf("removeAIStatus status change for %s",
@@ -129,11 +140,27 @@ func doUpdate(ctx *zedmanagerContext,
 		return changed
 	}
 
-	if !config.Activate {
+	activate := config.Activate
+	if activate {
+		c, ready := dependenciesReady(ctx, config, status)
+		changed = changed || c
+		activate = ready
+	}
+	if !activate {
 		if status.Activated || status.ActivateInprogress {
+			if c, ready := haltReady(ctx, status); !ready {
+				log.Functionf("Waiting for dependents to halt for %s",
+					uuidStr)
+				return changed || c
+			}
 			c := doInactivateHalt(ctx, config, status)
 			changed = changed || c
 		} else {
+			if !config.Activate {
+				// Clear any DependencyWait from before
+				c, _ := haltReady(ctx, status)
+				changed = changed || c
+			}
 			// Since we are not activating we set the state to
 			// HALTED to indicate it is not running since it
 			// might have been halted before the device was rebooted
@@ -142,7 +169,11 @@ func doUpdate(ctx *zedmanagerContext,
 				changed = true
 			}
 		}
-		log.Functionf("Waiting for config.Activate for %s", uuidStr)
+		if config.Activate {
+			log.Functionf("Waiting for dependencies for %s", uuidStr)
+		} else {
+			log.Functionf("Waiting for config.Activate for %s", uuidStr)
+		}
 		return changed
 	}
 	log.Functionf("Have config.Activate for %s", uuidStr)
@@ -164,7 +195,11 @@ func doInstall(ctx *zedmanagerContext,
 	var errorTime time.Time
 	changed := false
 
-	if len(config.VolumeRefConfigList) != len(status.VolumeRefStatusList) {
+	// Volume refs added to a running app instance are hot-plugged
+	hotplug := status.PurgeInprogress == types.NotInprogress &&
+		hotplugCapable(config, *status)
+	if len(config.VolumeRefConfigList) != len(status.VolumeRefStatusList) &&
+		!(hotplug && len(config.VolumeRefConfigList) > len(status.VolumeRefStatusList)) {
 		errString := fmt.Sprintf("Mismatch in volumeRefConfig vs. Status length: %d vs %d",
 			len(config.VolumeRefConfigList),
 			len(status.VolumeRefStatusList))
@@ -230,7 +265,7 @@ func doInstall(ctx *zedmanagerContext,
 		if vrs != nil {
 			continue
 		}
-		if status.PurgeInprogress == types.NotInprogress {
+		if status.PurgeInprogress == types.NotInprogress && !hotplug {
 			errString := fmt.Sprintf("New volumeRefConfig (VolumeID: %s, GenerationCounter: %d) found."+
 				"New Storage configs are not allowed unless purged",
 				vrc.VolumeID, vrc.GenerationCounter)
@@ -251,13 +286,18 @@ func doInstall(ctx *zedmanagerContext,
 		changed = true
 	}
 
-	if status.State < types.CREATED_VOLUME || status.PurgeInprogress != types.NotInprogress {
-		for i := range status.VolumeRefStatusList {
-			vrs := &status.VolumeRefStatusList[i]
-			c := doInstallVolumeRef(ctx, config, status, vrs)
-			if c {
-				changed = true
-			}
+	installAll := status.State < types.CREATED_VOLUME ||
+		status.PurgeInprogress != types.NotInprogress
+	for i := range status.VolumeRefStatusList {
+		vrs := &status.VolumeRefStatusList[i]
+		// A volume ref added to a running app instance is installed
+		// before domainmgr hot-plugs it
+		if !installAll && vrs.State >= types.CREATED_VOLUME {
+			continue
+		}
+		c := doInstallVolumeRef(ctx, config, status, vrs)
+		if c {
+			changed = true
 		}
 	}
 	// Determine minimum state and errors across all of VolumeRefStatus
@@ -467,6 +507,12 @@ func doActivate(ctx *zedmanagerContext, uuidStr string,
 		status.BootTime = ds.BootTime
 		changed = true
 	}
+	if updateRestartInfo(status, *ds) {
+		changed = true
+	}
+	if updatePodContainers(status, *ds) {
+		changed = true
+	}
 	c := updateVifUsed(status, *ds)
 	if c {
 		changed = true
@@ -588,6 +634,35 @@ func updateVifUsed(statusPtr *types.AppInstanceStatus, ds types.DomainStatus) bo
 	return changed
 }
 
+// Check if domainmgr restarted the domain or it is crash looping and
+// return true if so
+func updateRestartInfo(statusPtr *types.AppInstanceStatus, ds types.DomainStatus) bool {
+	if statusPtr.RestartCount == ds.RestartCount &&
+		statusPtr.CrashLoop == ds.CrashLoop &&
+		statusPtr.LastExitTime.Equal(ds.LastExitTime) {
+		return false
+	}
+	log.Functionf("Found restart count %d crash loop %t last exit %s for %s",
+		ds.RestartCount, ds.CrashLoop, ds.LastExitReason, statusPtr.Key())
+	statusPtr.RestartCount = ds.RestartCount
+	statusPtr.CrashLoop = ds.CrashLoop
+	statusPtr.LastExitReason = ds.LastExitReason
+	statusPtr.LastExitTime = ds.LastExitTime
+	return true
+}
+
+// Check if the status of the containers of a pod changed and return
+// true if so
+func updatePodContainers(statusPtr *types.AppInstanceStatus, ds types.DomainStatus) bool {
+	if cmp.Equal(statusPtr.PodContainers, ds.PodContainers) {
+		return false
+	}
+	log.Functionf("Found pod containers %v for %s", ds.PodContainers,
+		statusPtr.Key())
+	statusPtr.PodContainers = ds.PodContainers
+	return true
+}
+
 func purgeCmdDone(ctx *zedmanagerContext, config types.AppInstanceConfig,
 	status *types.AppInstanceStatus) bool {
 
@@ -869,6 +944,12 @@ func doInactivateHalt(ctx *zedmanagerContext,
 		status.BootTime = ds.BootTime
 		changed = true
 	}
+	if updateRestartInfo(status, *ds) {
+		changed = true
+	}
+	if updatePodContainers(status, *ds) {
+		changed = true
+	}
 	c := updateVifUsed(status, *ds)
 	if c {
 		changed = true
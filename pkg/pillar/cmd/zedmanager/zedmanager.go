@@ -11,6 +11,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
@@ -20,6 +21,7 @@ import (
 	"github.com/lf-edge/eve/pkg/pillar/pubsub"
 	"github.com/lf-edge/eve/pkg/pillar/types"
 	"github.com/lf-edge/eve/pkg/pillar/uuidtonum"
+	uuid "github.com/satori/go.uuid"
 	"github.com/sirupsen/logrus"
 )
 
@@ -48,6 +50,11 @@ type zedmanagerContext struct {
 	globalConfig         *types.ConfigItemValueMap
 	pubUuidToNum         pubsub.Publication
 	GCInitialized        bool
+	healthCheckers       map[string]*healthChecker // Indexed by AppInstanceStatus key
+	healthResults        chan healthResult
+
+	// Updates of app instances due to changes of their Dependencies
+	pendingDependencyUpdates map[string]bool
 }
 
 var debug = false
@@ -83,7 +90,10 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 
 	// Any state needed by handler functions
 	ctx := zedmanagerContext{
-		globalConfig: types.DefaultConfigItemValueMap(),
+		globalConfig:             types.DefaultConfigItemValueMap(),
+		healthCheckers:           make(map[string]*healthChecker),
+		healthResults:            make(chan healthResult),
+		pendingDependencyUpdates: make(map[string]bool),
 	}
 	// Create publish before subscribing and activating subscriptions
 	pubAppInstanceStatus, err := ps.NewPublication(pubsub.PublicationOptions{
@@ -281,8 +291,12 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 		case change := <-subAppInstanceConfig.MsgChan():
 			subAppInstanceConfig.ProcessChange(change)
 
+		case result := <-ctx.healthResults:
+			handleHealthResult(&ctx, result)
+
 		case <-stillRunning.C:
 		}
+		processDependencyUpdates(&ctx)
 		ps.StillRunning(agentName, warningTime, errorTime)
 	}
 }
@@ -326,8 +340,13 @@ func publishAppInstanceStatus(ctx *zedmanagerContext,
 
 	key := status.Key()
 	log.Tracef("publishAppInstanceStatus(%s)", key)
+	updateHealthChecker(ctx, status)
 	pub := ctx.pubAppInstanceStatus
+	old, _ := pub.Get(key)
 	pub.Publish(key, *status)
+	if dependencyStateChanged(old, *status) {
+		updateRelatedApps(ctx, key)
+	}
 }
 
 func unpublishAppInstanceStatus(ctx *zedmanagerContext,
@@ -335,6 +354,7 @@ func unpublishAppInstanceStatus(ctx *zedmanagerContext,
 
 	key := status.Key()
 	log.Tracef("unpublishAppInstanceStatus(%s)", key)
+	stopHealthChecker(ctx, key)
 	pub := ctx.pubAppInstanceStatus
 	st, _ := pub.Get(key)
 	if st == nil {
@@ -342,6 +362,7 @@ func unpublishAppInstanceStatus(ctx *zedmanagerContext,
 		return
 	}
 	pub.Unpublish(key)
+	updateRelatedApps(ctx, key)
 }
 
 func handleAppInstanceConfigDelete(ctxArg interface{}, key string,
@@ -457,6 +478,26 @@ func handleCreate(ctxArg interface{}, key string,
 		errStr := "Invalid Cpu count - 0\n"
 		allErrors += errStr
 	}
+	if fr := config.FixedResources; fr.MemoryMax != 0 && fr.MemoryMax < fr.Memory {
+		errStr := fmt.Sprintf("Invalid MemoryMax %d below Memory %d\n",
+			fr.MemoryMax, fr.Memory)
+		allErrors += errStr
+	}
+	if fr := config.FixedResources; fr.MemoryHigh != 0 && fr.MemoryMax != 0 &&
+		fr.MemoryHigh > fr.MemoryMax {
+		errStr := fmt.Sprintf("Invalid MemoryHigh %d above MemoryMax %d\n",
+			fr.MemoryHigh, fr.MemoryMax)
+		allErrors += errStr
+	}
+	if weight := config.FixedResources.BlkioWeight; weight != 0 &&
+		(weight < 10 || weight > 1000) {
+		errStr := fmt.Sprintf("Invalid BlkioWeight %d not in 10-1000\n",
+			weight)
+		allErrors += errStr
+	}
+	if err := checkPodContainers(config); err != nil {
+		allErrors += err.Error() + "\n"
+	}
 
 	if !ctx.globalConfig.GlobalValueBool(types.IgnoreMemoryCheckForApps) {
 		remaining, err := getRemainingMemory(ctx)
@@ -588,9 +629,81 @@ func handleDelete(ctx *zedmanagerContext, key string,
 	log.Functionf("handleDelete done for %s", status.DisplayName)
 }
 
+// checkPodContainers returns an error if the PodContainers don't each run
+// one of the volumes other than the first one, which is the main container
+func checkPodContainers(config types.AppInstanceConfig) error {
+	names := make(map[string]bool)
+	volumes := make(map[uuid.UUID]bool)
+	for _, pc := range config.PodContainers {
+		if pc.Name == "" || strings.ContainsAny(pc.Name, ".,/") {
+			return fmt.Errorf("Invalid pod container name %q", pc.Name)
+		}
+		if names[pc.Name] {
+			return fmt.Errorf("Duplicate pod container name %s", pc.Name)
+		}
+		names[pc.Name] = true
+		if volumes[pc.VolumeID] {
+			return fmt.Errorf("Volume %s used by more than one pod container",
+				pc.VolumeID)
+		}
+		volumes[pc.VolumeID] = true
+		found := false
+		for i, vrc := range config.VolumeRefConfigList {
+			if vrc.VolumeID != pc.VolumeID {
+				continue
+			}
+			if i == 0 {
+				return fmt.Errorf("Pod container %s can't use the volume of the main container",
+					pc.Name)
+			}
+			found = true
+		}
+		if !found {
+			return fmt.Errorf("Pod container %s uses unknown volume %s",
+				pc.Name, pc.VolumeID)
+		}
+	}
+	return nil
+}
+
+// hotplugCapable returns true if disks and network interfaces added to the
+// app instance can be hot-plugged into its running domain, which isn't
+// possible for the emulated devices of a LEGACY domain.
+func hotplugCapable(config types.AppInstanceConfig,
+	status types.AppInstanceStatus) bool {
+
+	return status.Activated &&
+		config.FixedResources.VirtualizationMode != types.LEGACY
+}
+
+// volumeRefsAdded returns true if config has all of the volume refs of
+// oldConfig and some more.
+func volumeRefsAdded(config types.AppInstanceConfig,
+	oldConfig types.AppInstanceConfig) bool {
+
+	if len(config.VolumeRefConfigList) <= len(oldConfig.VolumeRefConfigList) {
+		return false
+	}
+	for _, vrc := range oldConfig.VolumeRefConfigList {
+		found := false
+		for _, newVrc := range config.VolumeRefConfigList {
+			if newVrc.VolumeID == vrc.VolumeID &&
+				newVrc.GenerationCounter == vrc.GenerationCounter {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
 // Returns needRestart, needPurge, plus a string for each.
 // If there is a change to the disks, adapters, or network interfaces
-// it returns needPurge.
+// it returns needPurge, except for disks and network interfaces added to
+// a running app instance which are hot-plugged.
 // If there is a change to the CPU etc resources it returns needRestart
 // Changes to ACLs don't result in either being returned.
 func quantifyChanges(config types.AppInstanceConfig, oldConfig types.AppInstanceConfig,
@@ -601,7 +714,12 @@ func quantifyChanges(config types.AppInstanceConfig, oldConfig types.AppInstance
 	var purgeReason, restartReason string
 	log.Functionf("quantifyChanges for %s %s",
 		config.Key(), config.DisplayName)
-	if len(oldConfig.VolumeRefConfigList) != len(config.VolumeRefConfigList) {
+	hotplug := hotplugCapable(config, status)
+	if hotplug && volumeRefsAdded(config, oldConfig) {
+		log.Functionf("number of volume ref changed from %d to %d; hot-plugging",
+			len(oldConfig.VolumeRefConfigList),
+			len(config.VolumeRefConfigList))
+	} else if len(oldConfig.VolumeRefConfigList) != len(config.VolumeRefConfigList) {
 		str := fmt.Sprintf("number of volume ref changed from %d to %d",
 			len(oldConfig.VolumeRefConfigList),
 			len(config.VolumeRefConfigList))
@@ -621,7 +739,12 @@ func quantifyChanges(config types.AppInstanceConfig, oldConfig types.AppInstance
 			}
 		}
 	}
-	if len(oldConfig.UnderlayNetworkList) != len(config.UnderlayNetworkList) {
+	// Underlays appended to the end are hot-plugged; the existing ones
+	// have to stay the same
+	ulAdded := hotplug &&
+		len(config.UnderlayNetworkList) > len(oldConfig.UnderlayNetworkList)
+	if len(oldConfig.UnderlayNetworkList) != len(config.UnderlayNetworkList) &&
+		!ulAdded {
 		str := fmt.Sprintf("number of underlay interfaces changed from %d to %d",
 			len(oldConfig.UnderlayNetworkList),
 			len(config.UnderlayNetworkList))
@@ -629,8 +752,13 @@ func quantifyChanges(config types.AppInstanceConfig, oldConfig types.AppInstance
 		needPurge = true
 		purgeReason += str + "\n"
 	} else {
-		for i, uc := range config.UnderlayNetworkList {
-			old := oldConfig.UnderlayNetworkList[i]
+		if ulAdded {
+			log.Functionf("number of underlay interfaces changed from %d to %d; hot-plugging",
+				len(oldConfig.UnderlayNetworkList),
+				len(config.UnderlayNetworkList))
+		}
+		for i, old := range oldConfig.UnderlayNetworkList {
+			uc := config.UnderlayNetworkList[i]
 			if old.AppMacAddr.String() != uc.AppMacAddr.String() {
 				str := fmt.Sprintf("AppMacAddr changed from %v to %v",
 					old.AppMacAddr, uc.AppMacAddr)
@@ -672,6 +800,20 @@ func quantifyChanges(config types.AppInstanceConfig, oldConfig types.AppInstance
 		needRestart = true
 		restartReason += str + "\n"
 	}
+	if !cmp.Equal(config.SecurityProfile, oldConfig.SecurityProfile) {
+		str := fmt.Sprintf("SecurityProfile changed: %v",
+			cmp.Diff(oldConfig.SecurityProfile, config.SecurityProfile))
+		log.Functionf(str)
+		needRestart = true
+		restartReason += str + "\n"
+	}
+	if !cmp.Equal(config.PodContainers, oldConfig.PodContainers) {
+		str := fmt.Sprintf("PodContainers changed: %v",
+			cmp.Diff(oldConfig.PodContainers, config.PodContainers))
+		log.Functionf(str)
+		needRestart = true
+		restartReason += str + "\n"
+	}
 	log.Functionf("quantifyChanges for %s %s returns %v, %v",
 		config.Key(), config.DisplayName, needPurge, needRestart)
 	return needPurge, needRestart, purgeReason, restartReason
@@ -0,0 +1,120 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedmanager
+
+import (
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	uuid "github.com/satori/go.uuid"
+	"github.com/sirupsen/logrus"
+)
+
+func TestQuantifyChanges(t *testing.T) {
+	log = base.NewSourceLogObject(logrus.StandardLogger(), "zedmanager", 0)
+
+	vol1 := types.VolumeRefConfig{VolumeID: uuid.NewV4()}
+	vol2 := types.VolumeRefConfig{VolumeID: uuid.NewV4()}
+	vol3 := types.VolumeRefConfig{VolumeID: uuid.NewV4()}
+	net1 := types.UnderlayNetworkConfig{Network: uuid.NewV4()}
+	net2 := types.UnderlayNetworkConfig{Network: uuid.NewV4()}
+	appConfig := func(mode types.VmMode, vols []types.VolumeRefConfig,
+		nets []types.UnderlayNetworkConfig) types.AppInstanceConfig {
+
+		config := types.AppInstanceConfig{
+			VolumeRefConfigList: vols,
+			UnderlayNetworkList: nets,
+		}
+		config.FixedResources.VirtualizationMode = mode
+		return config
+	}
+	running := types.AppInstanceStatus{
+		Activated: true,
+		State:     types.RUNNING,
+		VolumeRefStatusList: []types.VolumeRefStatus{
+			{VolumeID: vol1.VolumeID},
+		},
+	}
+	halted := running
+	halted.Activated = false
+	halted.State = types.HALTED
+
+	testMatrix := map[string]struct {
+		config    types.AppInstanceConfig
+		oldConfig types.AppInstanceConfig
+		status    types.AppInstanceStatus
+		needPurge bool
+	}{
+		"unchanged": {
+			config:    appConfig(types.HVM, []types.VolumeRefConfig{vol1}, []types.UnderlayNetworkConfig{net1}),
+			oldConfig: appConfig(types.HVM, []types.VolumeRefConfig{vol1}, []types.UnderlayNetworkConfig{net1}),
+			status:    running,
+		},
+		"volume added to running app": {
+			config:    appConfig(types.HVM, []types.VolumeRefConfig{vol1, vol2}, []types.UnderlayNetworkConfig{net1}),
+			oldConfig: appConfig(types.HVM, []types.VolumeRefConfig{vol1}, []types.UnderlayNetworkConfig{net1}),
+			status:    running,
+		},
+		"volume added to halted app": {
+			config:    appConfig(types.HVM, []types.VolumeRefConfig{vol1, vol2}, []types.UnderlayNetworkConfig{net1}),
+			oldConfig: appConfig(types.HVM, []types.VolumeRefConfig{vol1}, []types.UnderlayNetworkConfig{net1}),
+			status:    halted,
+			needPurge: true,
+		},
+		"volume added to running legacy app": {
+			config:    appConfig(types.LEGACY, []types.VolumeRefConfig{vol1, vol2}, []types.UnderlayNetworkConfig{net1}),
+			oldConfig: appConfig(types.LEGACY, []types.VolumeRefConfig{vol1}, []types.UnderlayNetworkConfig{net1}),
+			status:    running,
+			needPurge: true,
+		},
+		"volume replaced in running app": {
+			config:    appConfig(types.HVM, []types.VolumeRefConfig{vol2, vol3}, []types.UnderlayNetworkConfig{net1}),
+			oldConfig: appConfig(types.HVM, []types.VolumeRefConfig{vol1}, []types.UnderlayNetworkConfig{net1}),
+			status:    running,
+			needPurge: true,
+		},
+		"volume removed from running app": {
+			config:    appConfig(types.HVM, nil, []types.UnderlayNetworkConfig{net1}),
+			oldConfig: appConfig(types.HVM, []types.VolumeRefConfig{vol1}, []types.UnderlayNetworkConfig{net1}),
+			status:    running,
+			needPurge: true,
+		},
+		"underlay added to running app": {
+			config:    appConfig(types.HVM, []types.VolumeRefConfig{vol1}, []types.UnderlayNetworkConfig{net1, net2}),
+			oldConfig: appConfig(types.HVM, []types.VolumeRefConfig{vol1}, []types.UnderlayNetworkConfig{net1}),
+			status:    running,
+		},
+		"underlay added to halted app": {
+			config:    appConfig(types.HVM, []types.VolumeRefConfig{vol1}, []types.UnderlayNetworkConfig{net1, net2}),
+			oldConfig: appConfig(types.HVM, []types.VolumeRefConfig{vol1}, []types.UnderlayNetworkConfig{net1}),
+			status:    halted,
+			needPurge: true,
+		},
+		"underlay inserted in running app": {
+			config:    appConfig(types.HVM, []types.VolumeRefConfig{vol1}, []types.UnderlayNetworkConfig{net2, net1}),
+			oldConfig: appConfig(types.HVM, []types.VolumeRefConfig{vol1}, []types.UnderlayNetworkConfig{net1}),
+			status:    running,
+			needPurge: true,
+		},
+		"underlay removed from running app": {
+			config:    appConfig(types.HVM, []types.VolumeRefConfig{vol1}, nil),
+			oldConfig: appConfig(types.HVM, []types.VolumeRefConfig{vol1}, []types.UnderlayNetworkConfig{net1}),
+			status:    running,
+			needPurge: true,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		needPurge, needRestart, purgeReason, _ := quantifyChanges(test.config,
+			test.oldConfig, test.status)
+		if needPurge != test.needPurge {
+			t.Errorf("%s: needPurge %t, expected %t: %s",
+				testname, needPurge, test.needPurge, purgeReason)
+		}
+		if needRestart {
+			t.Errorf("%s: unexpected needRestart", testname)
+		}
+	}
+}
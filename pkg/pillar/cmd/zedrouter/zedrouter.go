@@ -1228,11 +1228,23 @@ func handleAppNetworkModify(ctxArg interface{}, key string,
 		doAppNetworkModifyAllUnderlayNetworks(ctx, config, oldConfig, status, ipsets)
 
 		// Write out what we modified to AppNetworkStatus
-		// Note that lengths are the same as before
-		for i := range config.UnderlayNetworkList {
+		for i := range oldConfig.UnderlayNetworkList {
 			status.UnderlayNetworkList[i].UnderlayNetworkConfig =
 				config.UnderlayNetworkList[i]
 		}
+
+		// Activate the underlays added to the end, which domainmgr
+		// hot-plugs into the running domain
+		for i := len(oldConfig.UnderlayNetworkList); i < len(config.UnderlayNetworkList); i++ {
+			ulConfig := config.UnderlayNetworkList[i]
+			ulNum := i + 1
+			log.Functionf("handleAppNetworkModify adding ulNum %d network %s",
+				ulNum, ulConfig.Network.String())
+			status.UnderlayNetworkList = append(status.UnderlayNetworkList,
+				types.UnderlayNetworkStatus{UnderlayNetworkConfig: ulConfig})
+			appNetworkDoActivateUnderlayNetwork(
+				ctx, config, status, ipsets, &ulConfig, ulNum)
+		}
 	}
 
 	if config.Activate && !status.Activated {
@@ -1261,11 +1273,12 @@ func doAppNetworkSanityCheckForModify(ctx *zedrouterContext,
 	status *types.AppNetworkStatus) bool {
 	// XXX what about changing the number of interfaces as
 	// part of an inactive/active transition?
-	// XXX We could should we allow the addition of interfaces
-	// if the domU would find out through some hotplug event.
-	// But deletion is hard.
-	// For now don't allow any adds or deletes.
-	if len(config.UnderlayNetworkList) != len(oldConfig.UnderlayNetworkList) {
+	// Interfaces added to an activated app are hot-plugged into the
+	// domU, but deletion is hard hence isn't allowed.
+	added := status.Activated &&
+		len(config.UnderlayNetworkList) > len(oldConfig.UnderlayNetworkList)
+	if len(config.UnderlayNetworkList) != len(oldConfig.UnderlayNetworkList) &&
+		!added {
 		errStr := fmt.Sprintf("Unsupported: Changed number of underlays for %s",
 			config.UUIDandVersion)
 		addError(ctx, status, "handleModify", errors.New(errStr))
@@ -1317,7 +1330,7 @@ func doAppNetworkModifyAllUnderlayNetworks(
 	status *types.AppNetworkStatus,
 	ipsets []string) {
 
-	for i := range config.UnderlayNetworkList {
+	for i := range oldConfig.UnderlayNetworkList {
 		log.Tracef("handleModify ulNum %d\n", i)
 		ulConfig := &config.UnderlayNetworkList[i]
 		oldulConfig := &oldConfig.UnderlayNetworkList[i]
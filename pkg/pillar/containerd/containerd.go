@@ -14,6 +14,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"syscall"
 	"time"
 
 	"google.golang.org/grpc/connectivity"
@@ -31,6 +32,7 @@ import (
 	"github.com/eriknordmark/netlink"
 	"github.com/lf-edge/edge-containers/pkg/resolver"
 	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/lf-edge/eve/pkg/pillar/utils"
 	"github.com/opencontainers/go-digest"
 	"github.com/opencontainers/image-spec/identity"
 
@@ -65,6 +67,11 @@ const (
 	eveOCIMountPointsLabel = "org.lfedge.eve.blk_mounts"
 	// EVEOCIVNCPasswordLabel is OCI runtime spec label that tracks VNC password in OCI Image config
 	EVEOCIVNCPasswordLabel = "org.lfedge.eve.vnc_password"
+	// EVEOCIMemoryLabel is OCI runtime spec label with the memory in kbytes
+	// a domain which balloons is to start with
+	EVEOCIMemoryLabel = "org.lfedge.eve.memory"
+	// OCI runtime spec label of the main container of a pod that lists its additional containers
+	eveOCIPodContainersLabel = "org.lfedge.eve.pod_containers"
 
 	//TBD: Have a better way to calculate this number.
 	//For now it is based on some trial-and-error experiments
@@ -72,6 +79,9 @@ const (
 
 	// default signal to kill tasks
 	defaultSignal = "SIGTERM"
+
+	// prefix of the images holding checkpoints of tasks
+	checkpointImagePrefix = "checkpoint/"
 )
 
 var (
@@ -412,6 +422,50 @@ func (client *Client) CtrGetContainerMetrics(ctx context.Context, containerID st
 	}
 }
 
+// CtrGetContainerStats returns the usage of the cgroup resources by the
+// task of a container in the same form as the stats of app containers
+func (client *Client) CtrGetContainerStats(ctx context.Context, containerID string) (types.AppContainerStats, error) {
+	metric, err := client.CtrGetContainerMetrics(ctx, containerID)
+	if err != nil {
+		return types.AppContainerStats{}, err
+	}
+	stats := containerStats(metric)
+	stats.ContainerName = containerID
+	if _, _, status, err := client.CtrContainerInfo(ctx, containerID); err == nil {
+		stats.Status = status
+	}
+	return stats, nil
+}
+
+// containerStats converts the cgroup metrics; CPU in seconds, memory and
+// block IO in MBytes
+func containerStats(metric *v1stat.Metrics) types.AppContainerStats {
+	var stats types.AppContainerStats
+	if metric.Pids != nil {
+		stats.Pids = uint32(metric.Pids.Current)
+	}
+	if metric.CPU != nil && metric.CPU.Usage != nil {
+		stats.CPUTotal = metric.CPU.Usage.Total / uint64(time.Second)
+	}
+	if metric.Memory != nil && metric.Memory.Usage != nil {
+		stats.UsedMem = uint32(utils.RoundToMbytes(metric.Memory.Usage.Usage))
+		stats.AvailMem = uint32(utils.RoundToMbytes(metric.Memory.Usage.Limit))
+	}
+	if metric.Blkio != nil {
+		for _, entry := range metric.Blkio.IoServiceBytesRecursive {
+			switch strings.ToLower(entry.Op) {
+			case "read":
+				stats.ReadBytes += entry.Value
+			case "write":
+				stats.WriteBytes += entry.Value
+			}
+		}
+		stats.ReadBytes = utils.RoundToMbytes(stats.ReadBytes)
+		stats.WriteBytes = utils.RoundToMbytes(stats.WriteBytes)
+	}
+	return stats
+}
+
 // CtrContainerInfo returns PID, exit code and status of a container's main task
 // Status can be one of the: created, running, pausing, paused, stopped, unknown
 // For tasks that are in the running, pausing or paused state the PID is also provided
@@ -450,9 +504,19 @@ func (client *Client) CtrCreateTask(ctx context.Context, domainName string) (int
 		return 0, err
 	}
 
+	task, err := ctr.NewTask(ctx, taskIO(domainName))
+	if err != nil {
+		return 0, err
+	}
+
+	return int(task.Pid()), nil
+}
+
+// taskIO attaches the logging of the default task of a domain to memlogd
+func taskIO(domainName string) cio.Creator {
 	logger := GetLog()
 
-	io := func(id string) (cio.IO, error) {
+	return func(id string) (cio.IO, error) {
 		stdoutFile := logger.Path("guest_vm-" + domainName)
 		stderrFile := logger.Path("guest_vm_err-" + domainName)
 		return &logio{
@@ -464,14 +528,90 @@ func (client *Client) CtrCreateTask(ctx context.Context, domainName string) (int
 			},
 		}, nil
 	}
-	task, err := ctr.NewTask(ctx, io)
+}
+
+// checkpointRef is the name of the image holding a checkpoint of a domain
+func checkpointRef(domainName string, checkpointName string) string {
+	return checkpointImagePrefix + domainName + ":" + checkpointName
+}
+
+// CtrCheckpointTask checkpoints (using CRIU) the default task in a container into
+// an image named after the checkpointName; the task keeps running. An existing
+// checkpoint with the same name is replaced.
+func (client *Client) CtrCheckpointTask(ctx context.Context, domainName string, checkpointName string) error {
+	if err := client.verifyCtr(ctx, true); err != nil {
+		return fmt.Errorf("CtrCheckpointTask: exception while verifying ctrd client: %s", err.Error())
+	}
+	ctr, err := client.CtrLoadContainer(ctx, domainName)
+	if err != nil {
+		return err
+	}
+
+	task, err := ctr.Task(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	ref := checkpointRef(domainName, checkpointName)
+	// image store will refuse to create an image with the same name twice
+	_ = client.ctrdClient.ImageService().Delete(ctx, ref)
+	if _, err := task.Checkpoint(ctx, containerd.WithCheckpointName(ref)); err != nil {
+		return fmt.Errorf("CtrCheckpointTask: checkpoint %s failed: %v", ref, err)
+	}
+	return nil
+}
+
+// CtrRestoreTask replaces the default task in a container with the one saved by
+// CtrCheckpointTask under the checkpointName and starts it. Returns the PID of the new task.
+func (client *Client) CtrRestoreTask(ctx context.Context, domainName string, checkpointName string) (int, error) {
+	if err := client.verifyCtr(ctx, true); err != nil {
+		return 0, fmt.Errorf("CtrRestoreTask: exception while verifying ctrd client: %s", err.Error())
+	}
+	ctr, err := client.CtrLoadContainer(ctx, domainName)
 	if err != nil {
 		return 0, err
 	}
 
+	ref := checkpointRef(domainName, checkpointName)
+	image, err := client.ctrdClient.GetImage(ctx, ref)
+	if err != nil {
+		return 0, fmt.Errorf("CtrRestoreTask: no checkpoint %s: %v", ref, err)
+	}
+
+	// the current task (if any) has to go before we can create one from the checkpoint
+	_ = client.CtrStopContainer(ctx, domainName, true)
+
+	task, err := ctr.NewTask(ctx, taskIO(domainName), containerd.WithTaskCheckpoint(image))
+	if err != nil {
+		return 0, fmt.Errorf("CtrRestoreTask: failed to create task from %s: %v", ref, err)
+	}
+	if err := task.Start(ctx); err != nil {
+		return 0, fmt.Errorf("CtrRestoreTask: failed to start task from %s: %v", ref, err)
+	}
+
 	return int(task.Pid()), nil
 }
 
+// CtrDeleteCheckpoints removes all the checkpoints saved for a domain
+func (client *Client) CtrDeleteCheckpoints(ctx context.Context, domainName string) error {
+	if err := client.verifyCtr(ctx, true); err != nil {
+		return fmt.Errorf("CtrDeleteCheckpoints: exception while verifying ctrd client: %s", err.Error())
+	}
+	imgs, err := client.CtrListImages(ctx)
+	if err != nil {
+		return err
+	}
+	prefix := checkpointImagePrefix + domainName + ":"
+	for _, img := range imgs {
+		if strings.HasPrefix(img.Name, prefix) {
+			if err := client.CtrDeleteImage(ctx, img.Name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // CtrListTaskIds returns a list of all known tasks
 func (client *Client) CtrListTaskIds(ctx context.Context) ([]string, error) {
 	if err := client.verifyCtr(ctx, true); err != nil {
@@ -704,6 +844,77 @@ func (client *Client) ctrExec(ctx context.Context, domainName string, args []str
 	return stdOut.String(), stdErr.String(), err
 }
 
+// execTerminal is the terminal of a process started by CtrSystemExecTerminal
+type execTerminal struct {
+	io.Reader
+	io.Writer
+	close func() error
+}
+
+func (t *execTerminal) Close() error {
+	return t.close()
+}
+
+// CtrSystemExecTerminal starts the executable in a running system (EVE's) container
+// with a terminal. Reading and writing the returned stream reads and writes that
+// terminal, which gets EOF once the executable exits. Closing it kills the executable.
+// The ctx has to stay valid until then.
+func (client *Client) CtrSystemExecTerminal(ctx context.Context, containerName string, args []string) (io.ReadWriteCloser, error) {
+	if err := client.verifyCtr(ctx, true); err != nil {
+		return nil, fmt.Errorf("CtrSystemExecTerminal: exception while verifying ctrd client: %s", err.Error())
+	}
+	ctr, err := client.ctrdClient.LoadContainer(ctx, containerName)
+	if err != nil {
+		return nil, fmt.Errorf("CtrSystemExecTerminal: Exception while loading container: %v", err)
+	}
+
+	spec, err := ctr.Spec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	task, err := ctr.Task(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	pspec := spec.Process
+	pspec.Terminal = true
+	pspec.Args = args
+
+	stdinR, stdinW := io.Pipe()
+	stdoutR, stdoutW := io.Pipe()
+	cioOpts := []cio.Opt{cio.WithStreams(stdinR, stdoutW, stdoutW), cio.WithFIFODir(fifoDir), cio.WithTerminal}
+	process, err := task.Exec(ctx, fmt.Sprintf("%.50s%.20d", containerName, rand.Int()), pspec, cio.NewCreator(cioOpts...))
+	if err != nil {
+		return nil, err
+	}
+	statusC, err := process.Wait(ctx)
+	if err != nil {
+		process.Delete(ctx)
+		return nil, err
+	}
+	if err := process.Start(ctx); err != nil {
+		process.Delete(ctx)
+		return nil, err
+	}
+	go func() {
+		<-statusC
+		stdoutW.Close()
+	}()
+
+	return &execTerminal{
+		Reader: stdoutR,
+		Writer: stdinW,
+		close: func() error {
+			_ = process.Kill(ctx, syscall.SIGKILL)
+			stdinW.Close()
+			stdoutR.Close()
+			_, err := process.Delete(ctx, containerd.WithProcessKill)
+			return err
+		},
+	}, nil
+}
+
 // prepareProcess sets up anything that needs to be done after the container process is created,
 // but before it runs (for example networking)
 func prepareProcess(pid int, VifList []types.VifInfo) error {
@@ -10,6 +10,8 @@ package containerd
 
 import (
 	"fmt"
+	v1stat "github.com/containerd/cgroups/stats/v1"
+	"github.com/lf-edge/eve/pkg/pillar/types"
 	uuid "github.com/satori/go.uuid"
 	"io/ioutil"
 	"os"
@@ -116,3 +118,35 @@ func TestGetSnapshotID(t *testing.T) {
 		})
 	}
 }
+
+func TestContainerStats(t *testing.T) {
+	metric := &v1stat.Metrics{
+		Pids: &v1stat.PidsStat{Current: 12, Limit: 200},
+		CPU:  &v1stat.CPUStat{Usage: &v1stat.CPUUsage{Total: 90 * 1000000000}},
+		Memory: &v1stat.MemoryStat{
+			Usage: &v1stat.MemoryEntry{Usage: 300 << 20, Limit: 1024 << 20},
+		},
+		Blkio: &v1stat.BlkIOStat{
+			IoServiceBytesRecursive: []*v1stat.BlkIOEntry{
+				{Op: "Read", Value: 5 << 20},
+				{Op: "Write", Value: 7 << 20},
+				{Op: "Read", Value: 1 << 20},
+				{Op: "Total", Value: 13 << 20},
+			},
+		},
+	}
+	expected := types.AppContainerStats{
+		Pids:       12,
+		CPUTotal:   90,
+		UsedMem:    300,
+		AvailMem:   1024,
+		ReadBytes:  6,
+		WriteBytes: 7,
+	}
+	if stats := containerStats(metric); stats != expected {
+		t.Errorf("containerStats() = %+v, expected %+v", stats, expected)
+	}
+	if stats := containerStats(&v1stat.Metrics{}); stats != (types.AppContainerStats{}) {
+		t.Errorf("containerStats() of empty metrics = %+v", stats)
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/containerd/containerd"
@@ -55,6 +56,9 @@ type OCISpec interface {
 	AdjustMemLimit(types.DomainConfig, int64)
 	UpdateVifList([]types.VifInfo)
 	UpdateFromDomain(*types.DomainConfig)
+	UpdateFromSecurityProfile(*types.DomainConfig) error
+	UpdatePodContainers([]string)
+	JoinPod(*types.DomainConfig, string)
 	UpdateFromVolume(string) error
 	UpdateMounts([]types.DiskStatus) error
 	UpdateEnvVar(map[string]string)
@@ -302,15 +306,43 @@ func (s *ociSpec) UpdateFromDomain(dom *types.DomainConfig) {
 		}
 
 		m := int64(dom.Memory * 1024)
+		if dom.MemoryMax != 0 {
+			m = int64(dom.MemoryMax * 1024)
+		}
 		p := uint64(100000)
 		q := int64(100000 * dom.VCpus)
+		if dom.CPUQuota != 0 {
+			q = int64(1000 * dom.CPUQuota)
+		}
 		s.Linux.Resources.Memory.Limit = &m
 		s.Linux.Resources.CPU.Period = &p
 		s.Linux.Resources.CPU.Quota = &q
+		// cgroups v1 has no memory.high; the soft limit is the closest
+		if dom.MemoryHigh != 0 {
+			h := int64(dom.MemoryHigh * 1024)
+			s.Linux.Resources.Memory.Reservation = &h
+		}
+		if dom.CPUShares != 0 {
+			shares := dom.CPUShares
+			s.Linux.Resources.CPU.Shares = &shares
+		}
+		if dom.BlkioWeight != 0 {
+			if s.Linux.Resources.BlockIO == nil {
+				s.Linux.Resources.BlockIO = &specs.LinuxBlockIO{}
+			}
+			weight := dom.BlkioWeight
+			s.Linux.Resources.BlockIO.Weight = &weight
+		}
+		if dom.PidsLimit != 0 {
+			s.Linux.Resources.Pids = &specs.LinuxPids{Limit: dom.PidsLimit}
+		}
 
 		s.Linux.CgroupsPath = fmt.Sprintf("/%s/%s", ctrdServicesNamespace, dom.GetTaskName())
 	}
 	s.Annotations[EVEOCIVNCPasswordLabel] = dom.VncPasswd
+	if dom.Ballooning() {
+		s.Annotations[EVEOCIMemoryLabel] = strconv.Itoa(dom.Memory)
+	}
 }
 
 // UpdateFromVolume updates values in the OCI spec based on the location
@@ -399,6 +431,10 @@ func (s *ociSpec) UpdateMounts(disks []types.DiskStatus) error {
 		case zconfig.Format_FmtUnknown:
 			continue
 		case zconfig.Format_CONTAINER:
+			if disk.PodContainer != "" {
+				// runs as a container of its own in the pod
+				continue
+			}
 			if path.Clean(disk.MountDir) == "/" {
 				// skipping root volumes for now
 				continue
@@ -416,6 +416,34 @@ func TestOciSpec(t *testing.T) {
 	assert.Equal(t, 60, *s.Hooks.Poststop[1].Timeout)
 }
 
+func TestUpdateFromDomainResources(t *testing.T) {
+	client := &Client{}
+	spec, err := client.NewOciSpec("test")
+	if err != nil {
+		t.Fatalf("failed to create default OCI spec %v", err)
+	}
+	spec.UpdateFromDomain(&types.DomainConfig{
+		VmConfig: types.VmConfig{
+			Memory:      1024,
+			VCpus:       2,
+			CPUShares:   512,
+			CPUQuota:    150,
+			MemoryHigh:  2048,
+			MemoryMax:   4096,
+			BlkioWeight: 100,
+			PidsLimit:   200,
+		},
+	})
+
+	s := spec.Get()
+	assert.Equal(t, int64(4096*1024), *s.Linux.Resources.Memory.Limit)
+	assert.Equal(t, int64(2048*1024), *s.Linux.Resources.Memory.Reservation)
+	assert.Equal(t, uint64(512), *s.Linux.Resources.CPU.Shares)
+	assert.Equal(t, 1.5, float64(*s.Linux.Resources.CPU.Quota)/float64(*s.Linux.Resources.CPU.Period))
+	assert.Equal(t, uint16(100), *s.Linux.Resources.BlockIO.Weight)
+	assert.Equal(t, int64(200), s.Linux.Resources.Pids.Limit)
+}
+
 func TestCreateMountPointExecEnvFiles(t *testing.T) {
 
 	content := `{
@@ -0,0 +1,110 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package containerd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/containerd/containerd"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/opencontainers/runtime-spec/specs-go"
+)
+
+// A pod is the main container of a domain plus additional containers
+// created from the OCI images of other volumes of the domain. Each one
+// of those is a containerd container of its own named per
+// types.PodContainerTaskName, which joins the network namespace of the
+// main task once that is started, and whose cgroup is nested under the
+// one of the main task so that the resource limits apply to the pod.
+
+// UpdatePodContainers records the additional containers of the pod in
+// the spec of its main container
+func (s *ociSpec) UpdatePodContainers(containers []string) {
+	if len(containers) == 0 {
+		delete(s.Annotations, eveOCIPodContainersLabel)
+		return
+	}
+	s.Annotations[eveOCIPodContainersLabel] = strings.Join(containers, ",")
+}
+
+// JoinPod turns the spec into the one of an additional container of
+// the pod of the domain
+func (s *ociSpec) JoinPod(dom *types.DomainConfig, container string) {
+	if s.Linux == nil {
+		s.Linux = &specs.Linux{}
+	}
+	s.Linux.CgroupsPath = fmt.Sprintf("/%s/%s/%s", ctrdServicesNamespace,
+		dom.GetTaskName(), container)
+}
+
+// joinNetNS makes the spec use the network namespace of the process with pid
+func joinNetNS(spec *specs.Spec, pid int) {
+	if spec.Linux == nil {
+		spec.Linux = &specs.Linux{}
+	}
+	netns := specs.LinuxNamespace{
+		Type: specs.NetworkNamespace,
+		Path: fmt.Sprintf("/proc/%d/ns/net", pid),
+	}
+	for i, ns := range spec.Linux.Namespaces {
+		if ns.Type == specs.NetworkNamespace {
+			spec.Linux.Namespaces[i] = netns
+			return
+		}
+	}
+	spec.Linux.Namespaces = append(spec.Linux.Namespaces, netns)
+}
+
+// CtrGetPodContainers returns the names of the additional containers of
+// the pod of the domain; nil if it is not a pod
+func (client *Client) CtrGetPodContainers(ctx context.Context, domainName string) ([]string, error) {
+	if err := client.verifyCtr(ctx, true); err != nil {
+		return nil, fmt.Errorf("CtrGetPodContainers: exception while verifying ctrd client: %s", err.Error())
+	}
+	ctr, err := client.CtrLoadContainer(ctx, domainName)
+	if err != nil {
+		return nil, err
+	}
+	spec, err := ctr.Spec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if spec.Annotations[eveOCIPodContainersLabel] == "" {
+		return nil, nil
+	}
+	return strings.Split(spec.Annotations[eveOCIPodContainersLabel], ","), nil
+}
+
+// CtrStartPodContainer creates and starts the task of an additional
+// container of the pod of the domain in the network namespace of the main
+// task with pid. Its logging goes to memlogd under the name of the task.
+func (client *Client) CtrStartPodContainer(ctx context.Context, domainName string, container string, pid int) error {
+	if err := client.verifyCtr(ctx, true); err != nil {
+		return fmt.Errorf("CtrStartPodContainer: exception while verifying ctrd client: %s", err.Error())
+	}
+	id := types.PodContainerTaskName(domainName, container)
+	ctr, err := client.CtrLoadContainer(ctx, id)
+	if err != nil {
+		return err
+	}
+	spec, err := ctr.Spec(ctx)
+	if err != nil {
+		return err
+	}
+	joinNetNS(spec, pid)
+	if err := ctr.Update(ctx, containerd.UpdateContainerOpts(containerd.WithSpec(spec))); err != nil {
+		return fmt.Errorf("CtrStartPodContainer: failed to update container %s: %v", id, err)
+	}
+
+	// the task of the previous incarnation of the pod may still be around
+	_ = client.CtrStopContainer(ctx, id, true)
+
+	task, err := ctr.NewTask(ctx, taskIO(id))
+	if err != nil {
+		return fmt.Errorf("CtrStartPodContainer: failed to create task %s: %v", id, err)
+	}
+	return task.Start(ctx)
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package containerd
+
+import (
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPodSpecs(t *testing.T) {
+	client := &Client{}
+	dom := &types.DomainConfig{
+		UUIDandVersion: types.UUIDandVersion{Version: "1"},
+		AppNum:         3,
+	}
+	main, err := client.NewOciSpec(dom.GetTaskName())
+	if err != nil {
+		t.Fatalf("failed to create default OCI spec %v", err)
+	}
+	main.UpdateFromDomain(dom)
+	main.UpdatePodContainers([]string{"proxy", "logs"})
+	assert.Equal(t, "proxy,logs", main.Get().Annotations[eveOCIPodContainersLabel])
+	main.UpdatePodContainers(nil)
+	assert.NotContains(t, main.Get().Annotations, eveOCIPodContainersLabel)
+
+	name := types.PodContainerTaskName(dom.GetTaskName(), "proxy")
+	sidecar, err := client.NewOciSpec(name)
+	if err != nil {
+		t.Fatalf("failed to create default OCI spec %v", err)
+	}
+	sidecar.JoinPod(dom, "proxy")
+	assert.Equal(t, main.Get().Linux.CgroupsPath+"/proxy", sidecar.Get().Linux.CgroupsPath)
+
+	joinNetNS(sidecar.Get(), 1234)
+	netns := []specs.LinuxNamespace{}
+	for _, ns := range sidecar.Get().Linux.Namespaces {
+		if ns.Type == specs.NetworkNamespace {
+			netns = append(netns, ns)
+		}
+	}
+	assert.Equal(t, []specs.LinuxNamespace{{
+		Type: specs.NetworkNamespace,
+		Path: "/proc/1234/ns/net",
+	}}, netns)
+
+	domainName, container := types.PodContainerFromTaskName(name)
+	assert.Equal(t, dom.GetTaskName(), domainName)
+	assert.Equal(t, "proxy", container)
+	domainName, container = types.PodContainerFromTaskName(dom.GetTaskName())
+	assert.Equal(t, dom.GetTaskName(), domainName)
+	assert.Equal(t, "", container)
+}
@@ -0,0 +1,203 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package containerd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/opencontainers/runtime-spec/specs-go"
+)
+
+// ErrUserNamespace is returned for a SecurityProfile with a UserNamespace
+// since the rootfs snapshot of the container isn't remapped (nor chowned)
+// to the host ids root would be mapped to, hence root in the container
+// could not write its own files.
+var ErrUserNamespace = errors.New("user namespaces are not supported yet")
+
+// defaultSeccompDenied are the syscalls the EVE default seccomp profile
+// fails with EPERM; all the others are allowed. These are mostly the ones
+// which can affect the host or the other apps.
+var defaultSeccompDenied = []string{
+	"acct", "add_key", "bpf", "clock_adjtime", "clock_settime",
+	"create_module", "delete_module", "finit_module", "get_kernel_syms",
+	"get_mempolicy", "init_module", "ioperm", "iopl", "kcmp",
+	"kexec_file_load", "kexec_load", "keyctl", "lookup_dcookie", "mbind",
+	"move_pages", "nfsservctl", "open_by_handle_at", "perf_event_open",
+	"pivot_root", "process_vm_readv", "process_vm_writev", "ptrace",
+	"query_module", "quotactl", "reboot", "request_key", "set_mempolicy",
+	"setns", "settimeofday", "stime", "swapoff", "swapon", "sysfs",
+	"_sysctl", "umount", "umount2", "unshare", "uselib", "userfaultfd",
+	"ustat", "vm86", "vm86old",
+}
+
+// defaultSeccomp is the EVE default profile; runc fails the syscalls
+// with SCMP_ACT_ERRNO with EPERM
+func defaultSeccomp() *specs.LinuxSeccomp {
+	return &specs.LinuxSeccomp{
+		DefaultAction: specs.ActAllow,
+		Syscalls: []specs.LinuxSyscall{{
+			Names:  defaultSeccompDenied,
+			Action: specs.ActErrno,
+		}},
+	}
+}
+
+// ParseSeccomp returns the LinuxSeccomp for SecurityProfile.Seccomp, which
+// is nil if unconfined
+func ParseSeccomp(seccomp string) (*specs.LinuxSeccomp, error) {
+	switch seccomp {
+	case "", types.SeccompUnconfined:
+		return nil, nil
+	case types.SeccompDefault:
+		return defaultSeccomp(), nil
+	}
+	var profile specs.LinuxSeccomp
+	if err := json.Unmarshal([]byte(seccomp), &profile); err != nil {
+		return nil, fmt.Errorf("invalid seccomp profile: %v", err)
+	}
+	if profile.DefaultAction == "" {
+		return nil, fmt.Errorf("invalid seccomp profile: no defaultAction")
+	}
+	return &profile, nil
+}
+
+// CapabilityName returns the capability in the CAP_XXX form of OCI specs
+func CapabilityName(capability string) string {
+	capability = strings.ToUpper(capability)
+	if capability == "ALL" || strings.HasPrefix(capability, "CAP_") {
+		return capability
+	}
+	return "CAP_" + capability
+}
+
+// adjustCaps drops and adds capabilities to one of the capability sets
+func adjustCaps(caps []string, capDrop []string, capAdd []string) []string {
+	result := []string{}
+	drop := make(map[string]bool)
+	for _, c := range capDrop {
+		drop[CapabilityName(c)] = true
+	}
+	if !drop["ALL"] {
+		for _, c := range caps {
+			if !drop[c] {
+				result = append(result, c)
+			}
+		}
+	}
+	for _, c := range capAdd {
+		c = CapabilityName(c)
+		found := false
+		for _, r := range result {
+			if r == c {
+				found = true
+				break
+			}
+		}
+		if !found {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+// UpdateFromSecurityProfile hardens the spec per the SecurityProfile
+// of the domain
+func (s *ociSpec) UpdateFromSecurityProfile(dom *types.DomainConfig) error {
+	return applySecurityProfile(&s.Spec, dom.SecurityProfile)
+}
+
+func applySecurityProfile(spec *specs.Spec, profile types.SecurityProfile) error {
+	if profile.UserNamespace {
+		return ErrUserNamespace
+	}
+	seccomp, err := ParseSeccomp(profile.Seccomp)
+	if err != nil {
+		return err
+	}
+	if spec.Process == nil {
+		spec.Process = &specs.Process{}
+	}
+	if spec.Linux == nil {
+		spec.Linux = &specs.Linux{}
+	}
+	if seccomp != nil {
+		spec.Linux.Seccomp = seccomp
+	}
+	if profile.AppArmor != "" {
+		spec.Process.ApparmorProfile = profile.AppArmor
+	}
+	if len(profile.CapAdd) != 0 || len(profile.CapDrop) != 0 {
+		caps := spec.Process.Capabilities
+		if caps == nil {
+			caps = &specs.LinuxCapabilities{}
+			spec.Process.Capabilities = caps
+		}
+		caps.Bounding = adjustCaps(caps.Bounding, profile.CapDrop, profile.CapAdd)
+		caps.Effective = adjustCaps(caps.Effective, profile.CapDrop, profile.CapAdd)
+		caps.Permitted = adjustCaps(caps.Permitted, profile.CapDrop, profile.CapAdd)
+		caps.Inheritable = adjustCaps(caps.Inheritable, profile.CapDrop, profile.CapAdd)
+		// ambient capabilities have to stay a subset of the others
+		caps.Ambient = adjustCaps(caps.Ambient, profile.CapDrop, nil)
+	}
+	if profile.ReadOnlyRootfs {
+		if spec.Root == nil {
+			spec.Root = &specs.Root{}
+		}
+		spec.Root.Readonly = true
+	}
+	if profile.NoNewPrivileges {
+		spec.Process.NoNewPrivileges = true
+	}
+	return nil
+}
+
+// effectiveSecurityProfile reports the hardening of the spec
+func effectiveSecurityProfile(spec *specs.Spec) types.EffectiveSecurityProfile {
+	profile := types.EffectiveSecurityProfile{Seccomp: types.SeccompUnconfined}
+	if spec.Linux != nil {
+		if spec.Linux.Seccomp != nil {
+			if reflect.DeepEqual(spec.Linux.Seccomp, defaultSeccomp()) {
+				profile.Seccomp = types.SeccompDefault
+			} else {
+				profile.Seccomp = types.SeccompCustom
+			}
+		}
+		for _, mapping := range spec.Linux.UIDMappings {
+			if mapping.ContainerID == 0 {
+				profile.UIDMapHostID = mapping.HostID
+			}
+		}
+	}
+	if spec.Process != nil {
+		profile.AppArmor = spec.Process.ApparmorProfile
+		profile.NoNewPrivileges = spec.Process.NoNewPrivileges
+		if spec.Process.Capabilities != nil {
+			profile.Capabilities = spec.Process.Capabilities.Bounding
+		}
+	}
+	if spec.Root != nil {
+		profile.ReadOnlyRootfs = spec.Root.Readonly
+	}
+	return profile
+}
+
+// CtrGetSecurityProfile returns the hardening of the task of a container
+func (client *Client) CtrGetSecurityProfile(ctx context.Context, containerID string) (*types.EffectiveSecurityProfile, error) {
+	c, err := client.CtrLoadContainer(ctx, containerID)
+	if err != nil {
+		return nil, err
+	}
+	spec, err := c.Spec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	profile := effectiveSecurityProfile(spec)
+	return &profile, nil
+}
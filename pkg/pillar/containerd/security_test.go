@@ -0,0 +1,101 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package containerd
+
+import (
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecurityProfile(t *testing.T) {
+	testMatrix := map[string]struct {
+		profile  types.SecurityProfile
+		expected types.EffectiveSecurityProfile
+		fail     bool
+	}{
+		"none": {
+			expected: types.EffectiveSecurityProfile{
+				Seccomp:         types.SeccompUnconfined,
+				Capabilities:    defaultCapsForTest(t),
+				NoNewPrivileges: true, // containerd default
+			},
+		},
+		"hardened": {
+			profile: types.SecurityProfile{
+				Seccomp:         types.SeccompDefault,
+				AppArmor:        "eve-app",
+				CapDrop:         []string{"ALL"},
+				CapAdd:          []string{"net_bind_service", "CAP_CHOWN"},
+				ReadOnlyRootfs:  true,
+				NoNewPrivileges: true,
+			},
+			expected: types.EffectiveSecurityProfile{
+				Seccomp:         types.SeccompDefault,
+				AppArmor:        "eve-app",
+				Capabilities:    []string{"CAP_NET_BIND_SERVICE", "CAP_CHOWN"},
+				ReadOnlyRootfs:  true,
+				NoNewPrivileges: true,
+			},
+		},
+		"custom seccomp": {
+			profile: types.SecurityProfile{
+				Seccomp: `{"defaultAction": "SCMP_ACT_ERRNO", "syscalls": [{"names": ["read", "write"], "action": "SCMP_ACT_ALLOW"}]}`,
+				CapDrop: []string{"CAP_NET_RAW"},
+			},
+			expected: types.EffectiveSecurityProfile{
+				Seccomp:         types.SeccompCustom,
+				Capabilities:    withoutCap(defaultCapsForTest(t), "CAP_NET_RAW"),
+				NoNewPrivileges: true,
+			},
+		},
+		"bad seccomp": {
+			profile: types.SecurityProfile{Seccomp: "strict"},
+			fail:    true,
+		},
+		"user namespace": {
+			profile: types.SecurityProfile{UserNamespace: true},
+			fail:    true,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		client := &Client{}
+		spec, err := client.NewOciSpec("test")
+		if err != nil {
+			t.Fatalf("failed to create default OCI spec %v", err)
+		}
+		err = spec.UpdateFromSecurityProfile(&types.DomainConfig{
+			AppNum:          2,
+			SecurityProfile: test.profile,
+		})
+		if (err != nil) != test.fail {
+			t.Errorf("%s: unexpected error %v", testname, err)
+		}
+		if test.fail {
+			continue
+		}
+		assert.Equal(t, test.expected, effectiveSecurityProfile(spec.Get()), testname)
+	}
+}
+
+func defaultCapsForTest(t *testing.T) []string {
+	client := &Client{}
+	spec, err := client.NewOciSpec("test")
+	if err != nil {
+		t.Fatalf("failed to create default OCI spec %v", err)
+	}
+	return spec.Get().Process.Capabilities.Bounding
+}
+
+func withoutCap(caps []string, c string) []string {
+	result := []string{}
+	for _, r := range caps {
+		if r != c {
+			result = append(result, r)
+		}
+	}
+	return result
+}
@@ -8,7 +8,7 @@ type acrnContext struct {
 }
 
 func newAcrn() Hypervisor {
-	return acrnContext{}
+	return acrnContext{nullContext: newNull().(nullContext)}
 }
 
 // Name returns the name of this hypervisor implementation
@@ -0,0 +1,565 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Conformance suite every hypervisor has to pass: a domain goes through
+// its whole lifecycle and PCI devices get reserved and released. Backends
+// run against fake containerd, qemu, VMM and sysfs so that no actual
+// hypervisor is needed.
+
+package hypervisor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	v1stat "github.com/containerd/cgroups/stats/v1"
+	"github.com/lf-edge/eve/pkg/pillar/containerd"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+// fakeTask is a container along with its task, if it has one
+type fakeTask struct {
+	spec   containerd.OCISpec
+	task   bool
+	status string
+	pid    int
+	exit   int
+}
+
+// fakeContainerd keeps track of containers and tasks the way containerd
+// would. exec runs the commands of CtrExec and CtrSystemExec and onStart
+// is called as a task starts (in place of whatever the task would run).
+type fakeContainerd struct {
+	sync.Mutex
+	tasks   map[string]*fakeTask
+	lastPid int
+	exec    func(container string, args []string) (string, string, error)
+	onStart func(name string)
+}
+
+func newFakeContainerd() *fakeContainerd {
+	return &fakeContainerd{tasks: map[string]*fakeTask{}}
+}
+
+// fakeSpec is a real OCI spec which is created in fakeContainerd
+type fakeSpec struct {
+	containerd.OCISpec
+	name string
+	ctrd *fakeContainerd
+}
+
+func (s fakeSpec) CreateContainer(bool) error {
+	s.ctrd.Lock()
+	defer s.ctrd.Unlock()
+	if _, found := s.ctrd.tasks[s.name]; found {
+		return fmt.Errorf("container %s already exists", s.name)
+	}
+	s.ctrd.tasks[s.name] = &fakeTask{spec: s.OCISpec}
+	return nil
+}
+
+// AddLoader needs the xen-tools image, we run without a loader
+func (s fakeSpec) AddLoader(string) error {
+	return nil
+}
+
+// UpdateFromVolume needs an actual OCI image, we run without one
+func (s fakeSpec) UpdateFromVolume(string) error {
+	return nil
+}
+
+// setStatus changes the status of the task of a container
+func (f *fakeContainerd) setStatus(name, status string) {
+	f.Lock()
+	defer f.Unlock()
+	if t, found := f.tasks[name]; found && t.task {
+		t.status = status
+	}
+}
+
+func (f *fakeContainerd) NewOciSpec(name string) (containerd.OCISpec, error) {
+	spec, err := (&containerd.Client{}).NewOciSpec(name)
+	if err != nil {
+		return nil, err
+	}
+	return fakeSpec{OCISpec: spec, name: name, ctrd: f}, nil
+}
+
+func (f *fakeContainerd) CtrNewUserServicesCtx() (context.Context, context.CancelFunc) {
+	return context.WithCancel(context.Background())
+}
+
+func (f *fakeContainerd) CtrNewSystemServicesCtx() (context.Context, context.CancelFunc) {
+	return context.WithCancel(context.Background())
+}
+
+func (f *fakeContainerd) CtrCreateTask(ctx context.Context, domainName string) (int, error) {
+	f.Lock()
+	defer f.Unlock()
+	t, found := f.tasks[domainName]
+	if !found {
+		return 0, fmt.Errorf("no container %s", domainName)
+	}
+	f.lastPid++
+	t.task, t.status, t.pid, t.exit = true, "created", f.lastPid, 0
+	return t.pid, nil
+}
+
+func (f *fakeContainerd) CtrStartTask(ctx context.Context, domainName string) error {
+	f.Lock()
+	t, found := f.tasks[domainName]
+	if !found || !t.task || t.status != "created" {
+		f.Unlock()
+		return fmt.Errorf("no task %s to start", domainName)
+	}
+	t.status = "running"
+	f.Unlock()
+	if f.onStart != nil {
+		f.onStart(domainName)
+	}
+	return nil
+}
+
+func (f *fakeContainerd) CtrContainerInfo(ctx context.Context, name string) (int, int, string, error) {
+	f.Lock()
+	defer f.Unlock()
+	t, found := f.tasks[name]
+	if !found || !t.task {
+		return 0, 0, "", fmt.Errorf("no task %s", name)
+	}
+	return t.pid, t.exit, t.status, nil
+}
+
+func (f *fakeContainerd) CtrStopContainer(ctx context.Context, containerID string, force bool) error {
+	f.Lock()
+	defer f.Unlock()
+	t, found := f.tasks[containerID]
+	if !found || !t.task {
+		return fmt.Errorf("no task %s", containerID)
+	}
+	t.status = "stopped"
+	return nil
+}
+
+func (f *fakeContainerd) CtrDeleteContainer(ctx context.Context, containerID string) error {
+	f.Lock()
+	defer f.Unlock()
+	if _, found := f.tasks[containerID]; !found {
+		return fmt.Errorf("no container %s", containerID)
+	}
+	delete(f.tasks, containerID)
+	return nil
+}
+
+func (f *fakeContainerd) CtrCheckpointTask(ctx context.Context, domainName string, checkpointName string) error {
+	return fmt.Errorf("checkpoints are not supported")
+}
+
+func (f *fakeContainerd) CtrRestoreTask(ctx context.Context, domainName string, checkpointName string) (int, error) {
+	return 0, fmt.Errorf("checkpoints are not supported")
+}
+
+func (f *fakeContainerd) CtrDeleteCheckpoints(ctx context.Context, domainName string) error {
+	return nil
+}
+
+func (f *fakeContainerd) CtrListTaskIds(ctx context.Context) ([]string, error) {
+	f.Lock()
+	defer f.Unlock()
+	var ids []string
+	for id, t := range f.tasks {
+		if t.task {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+func (f *fakeContainerd) CtrGetContainerMetrics(ctx context.Context, containerID string) (*v1stat.Metrics, error) {
+	return nil, fmt.Errorf("metrics are not supported")
+}
+
+func (f *fakeContainerd) CtrGetAnnotations(ctx context.Context, containerID string) (map[string]string, error) {
+	f.Lock()
+	defer f.Unlock()
+	t, found := f.tasks[containerID]
+	if !found {
+		return nil, fmt.Errorf("no container %s", containerID)
+	}
+	return t.spec.Get().Annotations, nil
+}
+
+func (f *fakeContainerd) CtrGetSecurityProfile(ctx context.Context, containerID string) (*types.EffectiveSecurityProfile, error) {
+	return nil, nil
+}
+
+func (f *fakeContainerd) CtrGetPodContainers(ctx context.Context, domainName string) ([]string, error) {
+	return nil, nil
+}
+
+func (f *fakeContainerd) CtrStartPodContainer(ctx context.Context, domainName string, container string, pid int) error {
+	return fmt.Errorf("pods are not supported")
+}
+
+func (f *fakeContainerd) CtrExec(ctx context.Context, domainName string, args []string) (string, string, error) {
+	return f.run(domainName, args)
+}
+
+func (f *fakeContainerd) CtrSystemExec(ctx context.Context, domainName string, args []string) (string, string, error) {
+	return f.run(domainName, args)
+}
+
+func (f *fakeContainerd) CtrSystemExecTerminal(ctx context.Context, containerName string, args []string) (io.ReadWriteCloser, error) {
+	return nil, fmt.Errorf("terminals are not supported")
+}
+
+func (f *fakeContainerd) run(container string, args []string) (string, string, error) {
+	if f.exec == nil {
+		return "", "", fmt.Errorf("can't run %v in %s", args, container)
+	}
+	return f.exec(container, args)
+}
+
+// fakeQemu serves QMP on socket like qemu started with -S would: the
+// domain runs once continued and powering it down shuts it down, quit
+// closes the socket and calls onQuit with whether the domain was ballooned
+// before it ran
+func fakeQemu(t *testing.T, socket string, onQuit func(ballooned bool)) {
+	l, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Fatalf("can't listen on %s: %v", socket, err)
+	}
+	var mu sync.Mutex
+	status := "prelaunch"
+	ballooned := false
+	go func() {
+		for {
+			c, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func(c net.Conn) {
+				defer c.Close()
+				fmt.Fprintln(c, `{"QMP": {"version": {"qemu": {"micro": 0, "minor": 1, "major": 5}, "package": ""}, "capabilities": []}}`)
+				dec := json.NewDecoder(c)
+				for {
+					var cmd struct {
+						Execute string `json:"execute"`
+					}
+					if err := dec.Decode(&cmd); err != nil {
+						return
+					}
+					mu.Lock()
+					switch cmd.Execute {
+					case "qmp_capabilities":
+					case "query-status":
+						fmt.Fprintf(c, `{"return": {"status": "%s", "singlestep": false, "running": %t}}`+"\n",
+							status, status == "running")
+						mu.Unlock()
+						continue
+					case "cont":
+						status = "running"
+					case "stop":
+						status = "paused"
+					case "balloon":
+						// the guest must not run with more than Memory
+						if status != "prelaunch" {
+							fmt.Fprintf(c, `{"error": {"class": "GenericError", "desc": "balloon once %s"}}`+"\n", status)
+							mu.Unlock()
+							continue
+						}
+						ballooned = true
+					case "system_powerdown":
+						status = "shutdown"
+					case "quit":
+						fmt.Fprintln(c, `{"return": {}}`)
+						mu.Unlock()
+						l.Close()
+						onQuit(ballooned)
+						return
+					default:
+						fmt.Fprintf(c, `{"error": {"class": "CommandNotFound", "desc": "The command %s has not been found"}}`+"\n", cmd.Execute)
+						mu.Unlock()
+						continue
+					}
+					fmt.Fprintln(c, `{"return": {}}`)
+					mu.Unlock()
+				}
+			}(c)
+		}
+	}()
+}
+
+// conformanceEnv is a hypervisor along with what to run on it
+type conformanceEnv struct {
+	hyper    Hypervisor
+	status   types.DomainStatus
+	config   types.DomainConfig
+	stateDir string // Where the domain keeps its state, gone once deleted
+	pci      bool   // Whether PCI devices can be assigned
+	// checkPCI (if set) verifies the host after PCIReserve and PCIRelease
+	checkPCI func(long string, reserved bool) error
+}
+
+const conformanceDomain = "conformance.1"
+const conformancePCI = "0000:03:00.0"
+
+// testConformance runs a domain through its lifecycle on env.hyper
+func testConformance(t *testing.T, env conformanceEnv) {
+	env.status.DomainName = conformanceDomain
+	env.status.VirtualizationMode = env.config.VirtualizationMode
+	task := env.hyper.Task(&env.status)
+
+	dir, err := ioutil.TempDir("", "conformance")
+	if err != nil {
+		t.Fatalf("can't create temp dir %v", err)
+	}
+	defer os.RemoveAll(dir)
+	file, err := os.Create(filepath.Join(dir, "domain.cfg"))
+	if err != nil {
+		t.Fatalf("can't create config file %v", err)
+	}
+	defer file.Close()
+
+	if err := task.Setup(env.status, env.config, &types.AssignableAdapters{}, file); err != nil {
+		t.Fatalf("%s: Setup failed: %v", env.hyper.Name(), err)
+	}
+	domainID, err := task.Create(conformanceDomain, file.Name(), &env.config)
+	if err != nil {
+		t.Fatalf("%s: Create failed: %v", env.hyper.Name(), err)
+	}
+	if err := task.Start(conformanceDomain, domainID); err != nil {
+		t.Fatalf("%s: Start failed: %v", env.hyper.Name(), err)
+	}
+	if _, state, err := task.Info(conformanceDomain, domainID); err != nil || state != types.RUNNING {
+		t.Errorf("%s: domain is %v (%v) once started", env.hyper.Name(), state, err)
+	}
+
+	if err := task.Stop(conformanceDomain, domainID, false); err != nil {
+		t.Errorf("%s: Stop failed: %v", env.hyper.Name(), err)
+	}
+	if _, state, err := task.Info(conformanceDomain, domainID); err != nil ||
+		(state != types.HALTING && state != types.HALTED) {
+		t.Errorf("%s: domain is %v (%v) once stopped", env.hyper.Name(), state, err)
+	}
+
+	if err := task.Delete(conformanceDomain, domainID); err != nil {
+		t.Errorf("%s: Delete failed: %v", env.hyper.Name(), err)
+	}
+	if _, state, _ := task.Info(conformanceDomain, domainID); state == types.RUNNING {
+		t.Errorf("%s: domain still runs once deleted", env.hyper.Name())
+	}
+	if env.stateDir != "" {
+		if _, err := os.Stat(env.stateDir); !os.IsNotExist(err) {
+			t.Errorf("%s: %s left behind (%v)", env.hyper.Name(), env.stateDir, err)
+		}
+	}
+
+	if !env.pci {
+		if err := env.hyper.PCIReserve(conformancePCI); err == nil {
+			t.Errorf("%s: PCIReserve succeeded without device assignment", env.hyper.Name())
+		}
+		return
+	}
+	if err := env.hyper.PCIReserve(conformancePCI); err != nil {
+		t.Errorf("%s: PCIReserve failed: %v", env.hyper.Name(), err)
+	} else if env.checkPCI != nil {
+		if err := env.checkPCI(conformancePCI, true); err != nil {
+			t.Errorf("%s: after PCIReserve %v", env.hyper.Name(), err)
+		}
+	}
+	if err := env.hyper.PCIRelease(conformancePCI); err != nil {
+		t.Errorf("%s: PCIRelease failed: %v", env.hyper.Name(), err)
+	} else if env.checkPCI != nil {
+		if err := env.checkPCI(conformancePCI, false); err != nil {
+			t.Errorf("%s: after PCIRelease %v", env.hyper.Name(), err)
+		}
+	}
+}
+
+// fakeHost points the paths the hypervisors use on the host at a temp dir,
+// the function returned puts everything back
+func fakeHost(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "conformance-host")
+	if err != nil {
+		t.Fatalf("can't create temp dir %v", err)
+	}
+	paths := []*string{&vifsDir, &hotplugDir, &kvmStateDir, &sysfsPciDevices,
+		&sysfsPciDriversProbe, &vfioDriverPath, &xenTasksDir, &microvmStateDir}
+	saved := make([]string, len(paths))
+	for i, p := range paths {
+		saved[i] = *p
+		*p = filepath.Join(dir, strings.Trim(*p, "/")) + "/"
+	}
+	// drivers_probe is a file rather than a directory
+	sysfsPciDriversProbe = strings.TrimSuffix(sysfsPciDriversProbe, "/")
+	for _, d := range []string{xenTasksDir, sysfsPciDevices + conformancePCI} {
+		if err := os.MkdirAll(d, 0755); err != nil {
+			t.Fatalf("can't create %s: %v", d, err)
+		}
+	}
+	savedMount, savedUnmount := hostMount, hostUnmount
+	hostMount = func(source, target, fstype string, flags uintptr, data string) error {
+		return nil
+	}
+	hostUnmount = func(target string, flags int) error {
+		return nil
+	}
+	return dir, func() {
+		for i, p := range paths {
+			*p = saved[i]
+		}
+		hostMount, hostUnmount = savedMount, savedUnmount
+		os.RemoveAll(dir)
+	}
+}
+
+var conformanceVM = types.DomainConfig{
+	VmConfig: types.VmConfig{
+		Kernel:             "/hostfs/boot/kernel",
+		Memory:             262144,
+		VCpus:              1,
+		VirtualizationMode: types.HVM,
+	},
+}
+
+func TestConformance(t *testing.T) {
+	_, restore := fakeHost(t)
+	defer restore()
+	var cleanups []func()
+	defer func() {
+		for _, cleanup := range cleanups {
+			cleanup()
+		}
+	}()
+
+	testMatrix := map[string]func(*testing.T) conformanceEnv{
+		"null": func(t *testing.T) conformanceEnv {
+			null := newNull().(nullContext)
+			cleanups = append(cleanups, func() { os.RemoveAll(null.tempDir) })
+			return conformanceEnv{
+				hyper:    null,
+				stateDir: filepath.Join(null.tempDir, conformanceDomain),
+				pci:      true,
+			}
+		},
+		"acrn": func(t *testing.T) conformanceEnv {
+			acrn := newAcrn().(acrnContext)
+			cleanups = append(cleanups, func() { os.RemoveAll(acrn.tempDir) })
+			return conformanceEnv{
+				hyper:    acrn,
+				stateDir: filepath.Join(acrn.tempDir, conformanceDomain),
+				pci:      true,
+			}
+		},
+		"containerd": func(t *testing.T) conformanceEnv {
+			return conformanceEnv{
+				hyper: ctrdContext{PCI: map[string]bool{}, ctrdClient: newFakeContainerd()},
+				status: types.DomainStatus{
+					OCIConfigDir: "/persist/vault/volumes/container",
+				},
+				config:   types.DomainConfig{VmConfig: types.VmConfig{VirtualizationMode: types.NOHYPER}},
+				stateDir: filepath.Join(vifsDir, conformanceDomain),
+				pci:      true,
+			}
+		},
+		"xen": func(t *testing.T) conformanceEnv {
+			ctrd := newFakeContainerd()
+			assignable := map[string]bool{}
+			setState := func(state string) error {
+				return ioutil.WriteFile(xenTasksDir+conformanceDomain, []byte(state), 0644)
+			}
+			ctrd.onStart = func(string) { setState("running") }
+			ctrd.exec = func(container string, args []string) (string, string, error) {
+				switch strings.Join(args[:2], " ") {
+				case "xl shutdown":
+					return "", "", setState("halting")
+				case "xl destroy":
+					ctrd.setStatus(args[2], "stopped")
+					return "", "", nil
+				case "xl pci-assignable-add":
+					assignable[args[2]] = true
+					return "", "", nil
+				case "xl pci-assignable-rem":
+					delete(assignable, args[3])
+					return "", "", nil
+				}
+				return "", "unknown command", fmt.Errorf("exit status 1")
+			}
+			return conformanceEnv{
+				hyper:  xenContext{ctrdContext: ctrdContext{PCI: map[string]bool{}, ctrdClient: ctrd}},
+				config: conformanceVM,
+				pci:    true,
+				checkPCI: func(long string, reserved bool) error {
+					if assignable[long] != reserved {
+						return fmt.Errorf("%s assignable is %t", long, assignable[long])
+					}
+					return nil
+				},
+			}
+		},
+		"kvm": func(t *testing.T) conformanceEnv {
+			ctrd := newFakeContainerd()
+			ctrd.onStart = func(name string) {
+				fakeQemu(t, getQmpExecutorSocket(name), func(ballooned bool) {
+					if !ballooned {
+						t.Errorf("kvm: %s ran with MaxMem", name)
+					}
+					ctrd.setStatus(name, "stopped")
+				})
+			}
+			// qemu gets MaxMem and the domain is ballooned down to Memory
+			config := conformanceVM
+			config.MaxMem = 2 * config.Memory
+			return conformanceEnv{
+				hyper: kvmContext{
+					ctrdContext: ctrdContext{PCI: map[string]bool{}, ctrdClient: ctrd},
+					events:      make(chan types.DomainEvent, kvmEventsBacklog),
+					devicemodel: "pc-q35-3.1",
+					dmExec:      "/usr/lib/xen/bin/qemu-system-x86_64",
+				},
+				config:   config,
+				stateDir: kvmStateDir + conformanceDomain,
+				pci:      true,
+				checkPCI: func(long string, reserved bool) error {
+					override, err := ioutil.ReadFile(sysfsPciDevices + long + "/driver_override")
+					if err != nil {
+						return err
+					}
+					if (string(override) == "vfio-pci") != reserved {
+						return fmt.Errorf("driver_override of %s is %q", long, override)
+					}
+					if probe, err := ioutil.ReadFile(sysfsPciDriversProbe); err != nil || string(probe) != long {
+						return fmt.Errorf("drivers_probe got %q (%v)", probe, err)
+					}
+					return nil
+				},
+			}
+		},
+		"microvm": func(t *testing.T) conformanceEnv {
+			ctrd := newFakeContainerd()
+			ctrd.onStart = func(name string) {
+				vmm := &fakeVMM{devices: make(map[string]bool)}
+				cleanups = append(cleanups, serveFakeVMM(t, getMicrovmAPISocket(name), vmm))
+			}
+			return conformanceEnv{
+				hyper:    microvmContext{ctrdContext: ctrdContext{PCI: map[string]bool{}, ctrdClient: ctrd}},
+				config:   conformanceVM,
+				stateDir: microvmStateDir + conformanceDomain,
+			}
+		},
+	}
+	for testname, newEnv := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		testConformance(t, newEnv(t))
+	}
+}
@@ -4,25 +4,71 @@
 package hypervisor
 
 import (
+	"context"
 	"fmt"
+	"io"
+
+	v1stat "github.com/containerd/cgroups/stats/v1"
+	zconfig "github.com/lf-edge/eve/api/go/config"
 	"github.com/lf-edge/eve/pkg/pillar/containerd"
 	"github.com/lf-edge/eve/pkg/pillar/types"
 	"github.com/opencontainers/runtime-spec/specs-go"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
 )
 
 const (
-	vifsDir string = "/run/tasks/vifs"
+	// Volumes hot-plugged into a task are bind mounted under
+	// hotplugDir/<task>/<vdev> which is shared with the task as
+	// hotplugMountPoint/<vdev>
+	hotplugMountPoint string = "/dev/eve/volumes/hotplug"
+	vethScript        string = "/opt/zededa/bin/veth.sh"
 )
 
+// these are variables (along with hostMount and hostUnmount) only so that
+// the conformance tests can run the hypervisors on a fake host
+var (
+	vifsDir    = "/run/tasks/vifs"
+	hotplugDir = "/run/tasks/hotplug"
+
+	hostMount   = unix.Mount
+	hostUnmount = unix.Unmount
+)
+
+// containerdClient is what the hypervisors use of containerd.Client
+type containerdClient interface {
+	NewOciSpec(name string) (containerd.OCISpec, error)
+	CtrNewUserServicesCtx() (context.Context, context.CancelFunc)
+	CtrNewSystemServicesCtx() (context.Context, context.CancelFunc)
+	CtrCreateTask(ctx context.Context, domainName string) (int, error)
+	CtrStartTask(ctx context.Context, domainName string) error
+	CtrContainerInfo(ctx context.Context, name string) (int, int, string, error)
+	CtrStopContainer(ctx context.Context, containerID string, force bool) error
+	CtrDeleteContainer(ctx context.Context, containerID string) error
+	CtrCheckpointTask(ctx context.Context, domainName string, checkpointName string) error
+	CtrRestoreTask(ctx context.Context, domainName string, checkpointName string) (int, error)
+	CtrDeleteCheckpoints(ctx context.Context, domainName string) error
+	CtrListTaskIds(ctx context.Context) ([]string, error)
+	CtrGetContainerMetrics(ctx context.Context, containerID string) (*v1stat.Metrics, error)
+	CtrGetAnnotations(ctx context.Context, containerID string) (map[string]string, error)
+	CtrGetSecurityProfile(ctx context.Context, containerID string) (*types.EffectiveSecurityProfile, error)
+	CtrGetPodContainers(ctx context.Context, domainName string) ([]string, error)
+	CtrStartPodContainer(ctx context.Context, domainName string, container string, pid int) error
+	CtrExec(ctx context.Context, domainName string, args []string) (string, string, error)
+	CtrSystemExec(ctx context.Context, domainName string, args []string) (string, string, error)
+	CtrSystemExecTerminal(ctx context.Context, containerName string, args []string) (io.ReadWriteCloser, error)
+}
+
 type ctrdContext struct {
 	domCounter int
 	PCI        map[string]bool
-	ctrdClient *containerd.Client
+	ctrdClient containerdClient
 }
 
 func initContainerd() (*ctrdContext, error) {
@@ -78,6 +124,16 @@ func (ctx ctrdContext) setupSpec(status *types.DomainStatus, config *types.Domai
 	return spec, nil
 }
 
+// pinCPUs confines the task to the CPUs of the domain (if any) using the
+// cpuset cgroup. Note that it is only valid where the host sees all the
+// physical CPUs, i.e., not in a xen dom0.
+func pinCPUs(spec containerd.OCISpec, config types.DomainConfig) {
+	if config.CPUs != "" && spec.Get().Linux != nil && spec.Get().Linux.Resources != nil &&
+		spec.Get().Linux.Resources.CPU != nil {
+		spec.Get().Linux.Resources.CPU.Cpus = config.CPUs
+	}
+}
+
 func (ctx ctrdContext) Setup(status types.DomainStatus, config types.DomainConfig, aa *types.AssignableAdapters, file *os.File) error {
 	if status.OCIConfigDir == "" {
 		return logError("failed to run domain %s: not based on an OCI image", status.DomainName)
@@ -87,6 +143,10 @@ func (ctx ctrdContext) Setup(status types.DomainStatus, config types.DomainConfi
 	if err != nil {
 		return logError("setting up OCI spec for domain %s failed %v", status.DomainName, err)
 	}
+	pinCPUs(spec, config)
+	if err := spec.UpdateFromSecurityProfile(&config); err != nil {
+		return logError("applying the security profile of domain %s failed %v", status.DomainName, err)
+	}
 
 	vifsTaskResolv := filepath.Join(vifsDir, status.DomainName, "etc", "resolv.conf")
 	err = os.MkdirAll(filepath.Dir(vifsTaskResolv), 0755)
@@ -100,11 +160,37 @@ func (ctx ctrdContext) Setup(status types.DomainStatus, config types.DomainConfi
 	}
 	defer f.Close()
 
-	spec.Get().Mounts = append(spec.Get().Mounts, specs.Mount{
+	hotplugTaskDir := filepath.Join(hotplugDir, status.DomainName)
+	if err := setupHotplugDir(hotplugTaskDir); err != nil {
+		return logError("Failed to set up hotplug dir for task %s: %v", status.DomainName, err)
+	}
+
+	// all the containers of a pod share these
+	mounts := []specs.Mount{{
 		Type:        "bind",
 		Source:      vifsTaskResolv,
 		Destination: "/etc/resolv.conf",
-		Options:     []string{"rbind", "ro"}})
+		Options:     []string{"rbind", "ro"},
+	}, {
+		Type:        "bind",
+		Source:      hotplugTaskDir,
+		Destination: hotplugMountPoint,
+		Options:     []string{"rbind", "rw", "rslave"},
+	}}
+	spec.Get().Mounts = append(spec.Get().Mounts, mounts...)
+
+	var podContainers []string
+	for _, disk := range status.DiskStatusList {
+		if disk.PodContainer == "" {
+			continue
+		}
+		if err := ctx.setupPodContainer(&status, &config, disk, mounts); err != nil {
+			return logError("setting up container %s of pod %s failed %v",
+				disk.PodContainer, status.DomainName, err)
+		}
+		podContainers = append(podContainers, disk.PodContainer)
+	}
+	spec.UpdatePodContainers(podContainers)
 
 	if err := spec.CreateContainer(true); err != nil {
 		return logError("Failed to create container for task %s from %v: %v", status.DomainName, config, err)
@@ -113,6 +199,31 @@ func (ctx ctrdContext) Setup(status types.DomainStatus, config types.DomainConfi
 	return nil
 }
 
+// setupPodContainer creates the container of the pod of the domain which
+// runs the OCI image of the disk; its task is created once the main one runs
+func (ctx ctrdContext) setupPodContainer(status *types.DomainStatus, config *types.DomainConfig,
+	disk types.DiskStatus, mounts []specs.Mount) error {
+
+	if disk.Format != zconfig.Format_CONTAINER {
+		return fmt.Errorf("volume %s is not an OCI image", disk.DisplayName)
+	}
+	spec, err := ctx.ctrdClient.NewOciSpec(types.PodContainerTaskName(status.DomainName, disk.PodContainer))
+	if err != nil {
+		return err
+	}
+	if err := spec.UpdateFromVolume(disk.FileLocation); err != nil {
+		return err
+	}
+	spec.JoinPod(config, disk.PodContainer)
+	spec.UpdateMounts(status.DiskStatusList)
+	spec.UpdateEnvVar(status.EnvVariables)
+	if err := spec.UpdateFromSecurityProfile(config); err != nil {
+		return err
+	}
+	spec.Get().Mounts = append(spec.Get().Mounts, mounts...)
+	return spec.CreateContainer(true)
+}
+
 func (ctx ctrdContext) Create(domainName string, cfgFilename string, config *types.DomainConfig) (int, error) {
 	// if we are here we may need to get rid of the wedged, stale task just in case
 	// we are ignoring error here since it is cheaper to always call this as opposed
@@ -135,9 +246,12 @@ func (ctx ctrdContext) Start(domainName string, domainID int) error {
 
 	// now lets wait for task to reach a steady state or for >10sec to elapse
 	for i := 0; i < 10; i++ {
-		_, _, status, err := ctx.ctrdClient.CtrContainerInfo(ctrdCtx, domainName)
+		pid, _, status, err := ctx.ctrdClient.CtrContainerInfo(ctrdCtx, domainName)
 		if err == nil && (status == "running" || status == "stopped" || status == "paused") {
-			return nil
+			if status != "running" {
+				return nil
+			}
+			return ctx.startPodContainers(ctrdCtx, domainName, pid)
 		}
 		time.Sleep(time.Second)
 	}
@@ -145,25 +259,228 @@ func (ctx ctrdContext) Start(domainName string, domainID int) error {
 	return fmt.Errorf("task %s couldn't reach a steady state in time", domainName)
 }
 
+// startPodContainers starts the additional containers of the pod of the
+// domain, if any, in the network namespace of its main task with pid
+func (ctx ctrdContext) startPodContainers(ctrdCtx context.Context, domainName string, pid int) error {
+	containers, err := ctx.ctrdClient.CtrGetPodContainers(ctrdCtx, domainName)
+	if err != nil {
+		return logError("can't find the containers of pod %s: %v", domainName, err)
+	}
+	for _, container := range containers {
+		if err := ctx.ctrdClient.CtrStartPodContainer(ctrdCtx, domainName, container, pid); err != nil {
+			return logError("failed to start container %s of pod %s: %v", container, domainName, err)
+		}
+	}
+	return nil
+}
+
 func (ctx ctrdContext) Stop(domainName string, domainID int, force bool) error {
 	ctrdCtx, done := ctx.ctrdClient.CtrNewUserServicesCtx()
 	defer done()
+	// the additional containers of a pod go first since they live in
+	// the network namespace of the main task
+	containers, _ := ctx.ctrdClient.CtrGetPodContainers(ctrdCtx, domainName)
+	for _, container := range containers {
+		id := types.PodContainerTaskName(domainName, container)
+		if err := ctx.ctrdClient.CtrStopContainer(ctrdCtx, id, force); err != nil {
+			logrus.Warnf("stopping container %s of pod %s failed: %v", container, domainName, err)
+		}
+	}
 	return ctx.ctrdClient.CtrStopContainer(ctrdCtx, domainName, force)
 }
 
 func (ctx ctrdContext) Delete(domainName string, domainID int) error {
 	ctrdCtx, done := ctx.ctrdClient.CtrNewUserServicesCtx()
 	defer done()
+	containers, _ := ctx.ctrdClient.CtrGetPodContainers(ctrdCtx, domainName)
+	for _, container := range containers {
+		id := types.PodContainerTaskName(domainName, container)
+		if err := ctx.ctrdClient.CtrDeleteContainer(ctrdCtx, id); err != nil {
+			return logError("cannot delete container %s of pod %s: %v", container, domainName, err)
+		}
+	}
 	if err := ctx.ctrdClient.CtrDeleteContainer(ctrdCtx, domainName); err != nil {
 		return err
 	}
+	if err := ctx.ctrdClient.CtrDeleteCheckpoints(ctrdCtx, domainName); err != nil {
+		return logError("cannot remove checkpoints of task %s: %v", domainName, err)
+	}
 	vifsTaskDir := filepath.Join(vifsDir, domainName)
 	if err := os.RemoveAll(vifsTaskDir); err != nil {
 		return logError("cannot clear vifs task dir %s: %v", vifsTaskDir, err)
 	}
+	if err := removeHotplugDir(filepath.Join(hotplugDir, domainName)); err != nil {
+		return logError("cannot clear hotplug dir of task %s: %v", domainName, err)
+	}
+	return nil
+}
+
+// setupHotplugDir makes dir a shared mount point so that whatever we later
+// mount under it also shows up in the task which has it mounted as rslave
+func setupHotplugDir(dir string) error {
+	// start over if a previous incarnation of the task left it behind
+	if err := removeHotplugDir(dir); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	if err := hostMount(dir, dir, "", unix.MS_BIND, ""); err != nil {
+		return fmt.Errorf("bind mount of %s failed: %v", dir, err)
+	}
+	if err := hostMount("", dir, "", unix.MS_SHARED, ""); err != nil {
+		return fmt.Errorf("making %s shared failed: %v", dir, err)
+	}
+	return nil
+}
+
+// removeHotplugDir unmounts dir with anything mounted under it before
+// removing it; that way we never remove the content of a volume
+func removeHotplugDir(dir string) error {
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return nil
+	}
+	if err := hostUnmount(dir, unix.MNT_DETACH); err != nil && err != unix.EINVAL {
+		return fmt.Errorf("unmount of %s failed: %v", dir, err)
+	}
+	return os.RemoveAll(dir)
+}
+
+func (ctx ctrdContext) Snapshot(domainName string, domainID int, snapshotName string) error {
+	ctrdCtx, done := ctx.ctrdClient.CtrNewUserServicesCtx()
+	defer done()
+	if err := ctx.ctrdClient.CtrCheckpointTask(ctrdCtx, domainName, snapshotName); err != nil {
+		return logError("failed to checkpoint task %s as %s: %v", domainName, snapshotName, err)
+	}
+	return nil
+}
+
+func (ctx ctrdContext) Restore(domainName string, domainID int, snapshotName string) error {
+	ctrdCtx, done := ctx.ctrdClient.CtrNewUserServicesCtx()
+	defer done()
+	// the task gets a new PID which the caller picks up through Info
+	if _, err := ctx.ctrdClient.CtrRestoreTask(ctrdCtx, domainName, snapshotName); err != nil {
+		return logError("failed to restore task %s from %s: %v", domainName, snapshotName, err)
+	}
+	return nil
+}
+
+func (ctx ctrdContext) GuestInfo(domainName string, domainID int) (*types.GuestInfo, error) {
+	return nil, nil
+}
+
+func (ctx ctrdContext) SecurityProfile(domainName string) (*types.EffectiveSecurityProfile, error) {
+	ctrdCtx, done := ctx.ctrdClient.CtrNewUserServicesCtx()
+	defer done()
+	return ctx.ctrdClient.CtrGetSecurityProfile(ctrdCtx, domainName)
+}
+
+func (ctx ctrdContext) PodContainers(domainName string) ([]types.PodContainerStatus, error) {
+	ctrdCtx, done := ctx.ctrdClient.CtrNewUserServicesCtx()
+	defer done()
+	containers, err := ctx.ctrdClient.CtrGetPodContainers(ctrdCtx, domainName)
+	if err != nil {
+		return nil, err
+	}
+	var res []types.PodContainerStatus
+	for _, container := range containers {
+		status := types.PodContainerStatus{Name: container, State: types.HALTED}
+		id := types.PodContainerTaskName(domainName, container)
+		// no task means the container is not started yet or got deleted
+		if pid, exit, state, err := ctx.ctrdClient.CtrContainerInfo(ctrdCtx, id); err == nil {
+			status.PID = pid
+			status.ExitCode = uint32(exit)
+			status.State = taskState(state, exit)
+		}
+		res = append(res, status)
+	}
+	return res, nil
+}
+
+func (ctx ctrdContext) Console(domainName string, domainID int) (io.ReadWriteCloser, bool, error) {
+	// the output of a task already goes to its logs
+	return nil, true, nil
+}
+
+func (ctx ctrdContext) SetMemory(domainName string, domainID int, memory int) error {
+	return logError("ballooning of task %s is not supported", domainName)
+}
+
+func (ctx ctrdContext) AttachDisk(domainName string, domainID int, disk types.DiskStatus) error {
+	src := disk.FileLocation
+	if disk.Format == zconfig.Format_CONTAINER {
+		src = filepath.Join(src, "rootfs")
+	}
+	info, err := os.Stat(src)
+	if err != nil {
+		return logError("can't attach disk %s to task %s: %v", src, domainName, err)
+	}
+	// the mount point has to be of the same kind as what we mount on it
+	target := filepath.Join(hotplugDir, domainName, disk.Vdev)
+	if info.IsDir() {
+		err = os.MkdirAll(target, 0755)
+	} else {
+		var f *os.File
+		if f, err = os.OpenFile(target, os.O_CREATE|os.O_RDONLY, 0644); err == nil {
+			f.Close()
+		}
+	}
+	if err != nil {
+		return logError("can't create mount point %s for task %s: %v", target, domainName, err)
+	}
+	if err := hostMount(src, target, "", unix.MS_BIND|unix.MS_REC, ""); err != nil {
+		os.Remove(target)
+		return logError("can't bind mount %s to %s for task %s: %v", src, target, domainName, err)
+	}
+	if disk.ReadOnly {
+		if err := hostMount("", target, "", unix.MS_BIND|unix.MS_REMOUNT|unix.MS_RDONLY, ""); err != nil {
+			hostUnmount(target, unix.MNT_DETACH)
+			os.Remove(target)
+			return logError("can't make %s read-only for task %s: %v", target, domainName, err)
+		}
+	}
+	return nil
+}
+
+func (ctx ctrdContext) DetachDisk(domainName string, domainID int, disk types.DiskStatus) error {
+	target := filepath.Join(hotplugDir, domainName, disk.Vdev)
+	if err := hostUnmount(target, unix.MNT_DETACH); err != nil {
+		return logError("can't unmount %s for task %s: %v", target, domainName, err)
+	}
+	if err := os.Remove(target); err != nil {
+		return logError("can't remove mount point %s for task %s: %v", target, domainName, err)
+	}
+	return nil
+}
+
+// AttachVif runs the same script as the prestart hook of UpdateVifList which
+// expects the state of the task (only its pid is used) on stdin
+func (ctx ctrdContext) AttachVif(domainName string, domainID int, vif types.VifInfo) error {
+	cmd := exec.Command(vethScript, "up", domainName, vif.Vif, vif.Bridge, vif.Mac)
+	cmd.Stdin = strings.NewReader(fmt.Sprintf(`{"pid": %d}`, domainID))
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return logError("can't attach vif %s to task %s: %v %s", vif.Vif, domainName, err, out)
+	}
+	return nil
+}
+
+func (ctx ctrdContext) DetachVif(domainName string, domainID int, vif types.VifInfo) error {
+	cmd := exec.Command(vethScript, "down", vif.Vif)
+	cmd.Stdin = strings.NewReader(fmt.Sprintf(`{"pid": %d}`, domainID))
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return logError("can't detach vif %s from task %s: %v %s", vif.Vif, domainName, err, out)
+	}
 	return nil
 }
 
+func (ctx ctrdContext) AttachUsb(domainName string, domainID int, usbAddr string) error {
+	return logError("can't pass USB device %s through to task %s", usbAddr, domainName)
+}
+
+func (ctx ctrdContext) DetachUsb(domainName string, domainID int, usbAddr string) error {
+	return logError("can't take USB device %s back from task %s", usbAddr, domainName)
+}
+
 func (ctx ctrdContext) Annotations(domainName string, domainID int) (map[string]string, error) {
 	ctrdCtx, done := ctx.ctrdClient.CtrNewUserServicesCtx()
 	defer done()
@@ -187,14 +504,7 @@ func (ctx ctrdContext) Info(domainName string, domainID int) (int, types.SwState
 			domainName, effectiveDomainID, domainID, status)
 	}
 
-	stateMap := map[string]types.SwState{
-		"created": types.INSTALLED,
-		"running": types.RUNNING,
-		"pausing": types.PAUSING,
-		"paused":  types.PAUSED,
-		"stopped": types.HALTED,
-	}
-	if effectiveDomainState, matched := stateMap[status]; !matched {
+	if effectiveDomainState, matched := taskStateMap[status]; !matched {
 		err := fmt.Errorf("task %s happens to be in an unexpected state %s",
 			domainName, status)
 		logrus.Error(err)
@@ -204,6 +514,25 @@ func (ctx ctrdContext) Info(domainName string, domainID int) (int, types.SwState
 	}
 }
 
+var taskStateMap = map[string]types.SwState{
+	"created": types.INSTALLED,
+	"running": types.RUNNING,
+	"pausing": types.PAUSING,
+	"paused":  types.PAUSED,
+	"stopped": types.HALTED,
+}
+
+// taskState maps the status and exit code of a containerd task to SwState
+func taskState(status string, exit int) types.SwState {
+	if status == "stopped" && exit != 0 {
+		return types.BROKEN
+	}
+	if state, matched := taskStateMap[status]; matched {
+		return state
+	}
+	return types.BROKEN
+}
+
 func (ctx ctrdContext) PCIReserve(long string) error {
 	if ctx.PCI[long] {
 		return fmt.Errorf("PCI %s is already reserved", long)
@@ -229,6 +558,10 @@ func (ctx ctrdContext) GetHostCPUMem() (types.HostMemory, error) {
 const clockTicks uint64 = 100 // github.com/containerd/cgroups/ticks.go hardcoded as 100 also
 const nanoSecToSec uint64 = 1000000000
 
+func (ctx ctrdContext) DomainEvents() <-chan types.DomainEvent {
+	return nil
+}
+
 func (ctx ctrdContext) GetDomsCPUMem() (map[string]types.DomainMetric, error) {
 	res := map[string]types.DomainMetric{}
 	ctrdCtx, done := ctx.ctrdClient.CtrNewUserServicesCtx()
@@ -240,6 +573,11 @@ func (ctx ctrdContext) GetDomsCPUMem() (map[string]types.DomainMetric, error) {
 	}
 
 	for _, id := range ids {
+		// the cgroup of a pod holds the ones of all its containers, hence
+		// the main task accounts for them all
+		if _, container := types.PodContainerFromTaskName(id); container != "" {
+			continue
+		}
 		var usedMem, availMem, totalMem uint32
 		var usedMemPerc float64
 		var cpuTotal uint64
@@ -267,6 +605,7 @@ func (ctx ctrdContext) GetDomsCPUMem() (map[string]types.DomainMetric, error) {
 			UsedMemory:        usedMem,
 			AvailableMemory:   availMem,
 			UsedMemoryPercent: usedMemPerc,
+			AllocatedMB:       totalMem,
 		}
 	}
 	return res, nil
@@ -24,6 +24,10 @@ type Hypervisor interface {
 	GetDomsCPUMem() (map[string]types.DomainMetric, error)
 
 	GetCapabilities() (*types.Capabilities, error)
+
+	// DomainEvents returns the channel on which events of all the domains
+	// are reported; a nil channel if the hypervisor doesn't report events
+	DomainEvents() <-chan types.DomainEvent
 }
 
 type hypervisorDesc struct {
@@ -34,13 +38,14 @@ type hypervisorDesc struct {
 var knownHypervisors = map[string]hypervisorDesc{
 	"xen":        {constructor: newXen, dom0handle: "/proc/xen"},
 	"kvm":        {constructor: newKvm, dom0handle: "/dev/kvm"},
+	"microvm":    {constructor: newMicrovm, dom0handle: "/dev/kvm"},
 	"acrn":       {constructor: newAcrn, dom0handle: "/dev/acrn"},
 	"containerd": {constructor: newContainerd, dom0handle: "/run/containerd/containerd.sock"},
 	"null":       {constructor: newNull, dom0handle: "/"},
 }
 
 // this is a priority order to pick a default hypervisor if multiple are availabel (more to less likely)
-var hypervisorPriority = []string{"xen", "kvm", "acrn", "containerd", "null"}
+var hypervisorPriority = []string{"xen", "kvm", "microvm", "acrn", "containerd", "null"}
 
 // GetHypervisor returns a particular hypervisor implementation
 func GetHypervisor(hint string) (Hypervisor, error) {
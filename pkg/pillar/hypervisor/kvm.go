@@ -5,9 +5,12 @@ package hypervisor
 
 import (
 	"fmt"
+	"io"
 	"io/ioutil"
+	"net"
 	"os"
 	"runtime"
+	"strconv"
 	"strings"
 	"text/template"
 	"time"
@@ -104,7 +107,7 @@ const qemuConfTemplate = `# This file is automatically generated by domainmgr
 
 [chardev "charmonitor"]
   backend = "socket"
-  path = "` + kvmStateDir + `{{.DisplayName}}/qmp"
+  path = "{{.StateDir}}{{.DisplayName}}/qmp"
   server = "on"
   wait = "off"
 
@@ -114,7 +117,7 @@ const qemuConfTemplate = `# This file is automatically generated by domainmgr
 
 [chardev "charlistener"]
   backend = "socket"
-  path = "` + kvmStateDir + `{{.DisplayName}}/listener.qmp"
+  path = "{{.StateDir}}{{.DisplayName}}/listener.qmp"
   server = "on"
   wait = "off"
 
@@ -124,7 +127,10 @@ const qemuConfTemplate = `# This file is automatically generated by domainmgr
 
 [memory]
   size = "{{.Memory}}"
-
+{{if .Ballooning}}
+[device "balloon0"]
+  driver = "virtio-balloon-pci"
+{{end}}
 [smp-opts]
   cpus = "{{.VCpus}}"
   sockets = "1"
@@ -138,7 +144,7 @@ const qemuConfTemplate = `# This file is automatically generated by domainmgr
 [chardev "charserial0"]
   backend = "socket"
   mux = "on"
-  path = "` + kvmStateDir + `{{.DisplayName}}/cons"
+  path = "{{.StateDir}}{{.DisplayName}}/cons"
   server = "on"
   wait = "off"
   logfile = "/dev/fd/1"
@@ -148,7 +154,35 @@ const qemuConfTemplate = `# This file is automatically generated by domainmgr
   driver = "virtconsole"
   chardev = "charserial0"
   name = "org.lfedge.eve.console.0"
+{{if .EnableGuestAgent}}
+[chardev "charqga"]
+  backend = "socket"
+  path = "{{.StateDir}}{{.DisplayName}}/qga"
+  server = "on"
+  wait = "off"
+
+[device]
+  driver = "virtserialport"
+  chardev = "charqga"
+  name = "org.qemu.guest_agent.0"
+{{end}}
+{{- if .EnableVTPM}}
+[chardev "chartpm"]
+  backend = "socket"
+  path = "{{.StateDir}}{{.DisplayName}}/swtpm"
 
+[tpmdev "tpm0"]
+  type = "emulator"
+  chardev = "chartpm"
+
+[device "tpm"]
+{{- if eq .Machine "virt" }}
+  driver = "tpm-tis-device"
+{{- else }}
+  driver = "tpm-crb"
+{{- end }}
+  tpmdev = "tpm0"
+{{end}}
 {{if .EnableVnc}}
 [vnc "default"]
   vnc = "0.0.0.0:{{if .VncDisplay}}{{.VncDisplay}}{{else}}0{{end}}"
@@ -294,6 +328,15 @@ const qemuNetTemplate = `
   addr = "0x0"
 `
 
+const qemuHotplugTemplate = `
+[device "hotplug{{.ID}}"]
+  driver = "pcie-root-port"
+  port = "1{{.PCIId}}"
+  chassis = "{{.PCIId}}"
+  bus = "pcie.0"
+  addr = "{{.PCIId}}"
+`
+
 const qemuPciPassthruTemplate = `
 [device]
   driver = "vfio-pci"
@@ -313,17 +356,21 @@ const qemuSerialTemplate = `
 `
 
 const qemuUsbHostTemplate = `
-[device]
+[device "{{.ID}}"]
   driver = "usb-host"
   hostbus = "{{.UsbBus}}"
   hostaddr = "{{.UsbDevAddr}}"
 `
 
-const kvmStateDir = "/run/hypervisor/kvm/"
-const sysfsPciDevices = "/sys/bus/pci/devices/"
+// variables for the sake of the conformance tests
+var (
+	kvmStateDir          = "/run/hypervisor/kvm/"
+	sysfsPciDevices      = "/sys/bus/pci/devices/"
+	sysfsPciDriversProbe = "/sys/bus/pci/drivers_probe"
+	vfioDriverPath       = "/sys/bus/pci/drivers/vfio-pci"
+)
+
 const sysfsVfioPciBind = "/sys/bus/pci/drivers/vfio-pci/bind"
-const sysfsPciDriversProbe = "/sys/bus/pci/drivers_probe"
-const vfioDriverPath = "/sys/bus/pci/drivers/vfio-pci"
 
 // KVM domains map 1-1 to anchor device model UNIX processes (qemu or firecracker)
 // For every anchor process we maintain the following entry points in the
@@ -343,8 +390,19 @@ type kvmContext struct {
 	dmCPUArgs    []string
 	dmFmlCPUArgs []string
 	capabilities *types.Capabilities
+	// events of all the domains, see qmpEventHandler
+	events chan types.DomainEvent
 }
 
+// kvmEventsBacklog is how many domain events we queue for domainmgr
+const kvmEventsBacklog = 64
+
+// kvmHotplugPorts is how many disks and vifs can be hot-plugged into a domain
+const kvmHotplugPorts = 4
+
+// kvmUnplugRetries is how many seconds we give a guest to release a device
+const kvmUnplugRetries = 10
+
 func newKvm() Hypervisor {
 	ctrdCtx, err := initContainerd()
 	if err != nil {
@@ -359,6 +417,7 @@ func newKvm() Hypervisor {
 	case "arm64":
 		return kvmContext{
 			ctrdContext:  *ctrdCtx,
+			events:       make(chan types.DomainEvent, kvmEventsBacklog),
 			devicemodel:  "virt",
 			dmExec:       "/usr/lib/xen/bin/qemu-system-aarch64",
 			dmArgs:       []string{"-display", "none", "-S", "-no-user-config", "-nodefaults", "-no-shutdown", "-overcommit", "mem-lock=on", "-overcommit", "cpu-pm=on", "-serial", "chardev:charserial0"},
@@ -369,6 +428,7 @@ func newKvm() Hypervisor {
 		return kvmContext{
 			//nolint:godox // FIXME: Removing "-overcommit", "mem-lock=on", "-overcommit" for now, revisit it later as part of resource partitioning
 			ctrdContext:  *ctrdCtx,
+			events:       make(chan types.DomainEvent, kvmEventsBacklog),
 			devicemodel:  "pc-q35-3.1",
 			dmExec:       "/usr/lib/xen/bin/qemu-system-x86_64",
 			dmArgs:       []string{"-display", "none", "-S", "-no-user-config", "-nodefaults", "-no-shutdown", "-serial", "chardev:charserial0", "-no-hpet"},
@@ -425,6 +485,9 @@ func (ctx kvmContext) Setup(status types.DomainStatus, config types.DomainConfig
 	diskStatusList := status.DiskStatusList
 	domainName := status.DomainName
 	domainUUID := status.UUIDandVersion.UUID
+	if status.IsPod() {
+		return logError("failed to run domain %s: pods only run as containers", domainName)
+	}
 	// first lets build the domain config
 	if err := ctx.CreateDomConfig(domainName, config, diskStatusList, aa, file); err != nil {
 		return logError("failed to build domain config: %v", err)
@@ -439,7 +502,16 @@ func (ctx kvmContext) Setup(status types.DomainStatus, config types.DomainConfig
 
 	os.MkdirAll(kvmStateDir+domainName, 0777)
 
-	args := []string{ctx.dmExec}
+	var args []string
+	if config.EnableVTPM {
+		if status.VTPMStateDir == "" {
+			return logError("failed to run domain %s: no state directory for its TPM", domainName)
+		}
+		// swtpm goes away together with qemu
+		args = append(args, "/etc/xen/scripts/swtpm-start",
+			status.VTPMStateDir, getSwtpmSocket(domainName))
+	}
+	args = append(args, ctx.dmExec)
 	args = append(args, dmArgs...)
 	args = append(args, "-name", domainName,
 		"-uuid", domainUUID.String(),
@@ -454,7 +526,11 @@ func (ctx kvmContext) Setup(status types.DomainStatus, config types.DomainConfig
 		return logError("failed to add kvm hypervisor loader to domain %s: %v", status.DomainName, err)
 	}
 
-	spec.AdjustMemLimit(config, qemuOverHead)
+	memConfig := config
+	memConfig.Memory = config.MaxMemOrDefault()
+	spec.AdjustMemLimit(memConfig, qemuOverHead)
+	// qemu threads, hence vCPUs, inherit the CPUs of the task just as with taskset
+	pinCPUs(spec, config)
 	spec.Get().Process.Args = args
 	logrus.Infof("Hypervisor args: %v", args)
 
@@ -468,10 +544,13 @@ func (ctx kvmContext) Setup(status types.DomainStatus, config types.DomainConfig
 func (ctx kvmContext) CreateDomConfig(domainName string, config types.DomainConfig, diskStatusList []types.DiskStatus,
 	aa *types.AssignableAdapters, file *os.File) error {
 	tmplCtx := struct {
-		Machine string
+		Machine  string
+		StateDir string
 		types.DomainConfig
-	}{ctx.devicemodel, config}
-	tmplCtx.Memory = (config.Memory + 1023) / 1024
+	}{ctx.devicemodel, kvmStateDir, config}
+	// with ballooning qemu starts out with MaxMem and Start inflates
+	// the balloon down to Memory before the domain runs
+	tmplCtx.Memory = (config.MaxMemOrDefault() + 1023) / 1024
 	tmplCtx.DisplayName = domainName
 
 	// render global device model settings
@@ -530,6 +609,18 @@ func (ctx kvmContext) CreateDomConfig(domainName string, config types.DomainConf
 		netContext.NetID = netContext.NetID + 1
 	}
 
+	// render spare root ports for disks and vifs hot-plugged later on
+	hotplugContext := struct {
+		PCIId, ID int
+	}{PCIId: netContext.PCIId, ID: 0}
+	t, _ = template.New("qemuHotplug").Parse(qemuHotplugTemplate)
+	for ; hotplugContext.ID < kvmHotplugPorts; hotplugContext.ID++ {
+		if err := t.Execute(file, hotplugContext); err != nil {
+			return logError("can't write to config file %s (%v)", file.Name(), err)
+		}
+		hotplugContext.PCIId = hotplugContext.PCIId + 1
+	}
+
 	// Gather all PCI assignments into a single line
 	var pciAssignments []typeAndPCI
 	// Gather all USB assignments into a single line
@@ -608,14 +699,16 @@ func (ctx kvmContext) CreateDomConfig(domainName string, config types.DomainConf
 	}
 	if len(usbAssignments) != 0 {
 		usbHostContext := struct {
+			ID         string
 			UsbBus     string
 			UsbDevAddr string
 			// Ports are dot-separated
-		}{UsbBus: "", UsbDevAddr: ""}
+		}{ID: "", UsbBus: "", UsbDevAddr: ""}
 
 		t, _ = template.New("qemuUsbHost").Parse(qemuUsbHostTemplate)
 		for _, usbaddr := range usbAssignments {
 			bus, port := usbBusPort(usbaddr)
+			usbHostContext.ID = usbDeviceID(usbaddr)
 			usbHostContext.UsbBus = bus
 			usbHostContext.UsbDevAddr = port
 			if err := t.Execute(file, usbHostContext); err != nil {
@@ -671,7 +764,7 @@ func (ctx kvmContext) Start(domainName string, domainID int) error {
 
 	logrus.Debugf("starting qmpEventHandler")
 	logrus.Infof("Creating %s at %s", "qmpEventHandler", agentlog.GetMyStack())
-	go qmpEventHandler(getQmpListenerSocket(domainName), getQmpExecutorSocket(domainName))
+	go qmpEventHandler(getQmpListenerSocket(domainName), getQmpExecutorSocket(domainName), domainName, ctx.events)
 
 	annotations, err := ctx.ctrdContext.Annotations(domainName, domainID)
 	if err != nil {
@@ -685,6 +778,17 @@ func (ctx kvmContext) Start(domainName string, domainID int) error {
 		}
 	}
 
+	// the guest only gets more than Memory once domainmgr gives it more
+	if memory, ok := annotations[containerd.EVEOCIMemoryLabel]; ok && memory != "" {
+		kbytes, err := strconv.Atoi(memory)
+		if err != nil {
+			return logError("bad memory %s for domain %s: %v", memory, domainName, err)
+		}
+		if err := execBalloon(qmpFile, int64(kbytes)*1024); err != nil {
+			return logError("failed to balloon domain %s to %d kbytes: %v", domainName, kbytes, err)
+		}
+	}
+
 	if err := execContinue(qmpFile); err != nil {
 		return logError("failed to start domain that is stopped %v", err)
 	}
@@ -695,7 +799,23 @@ func (ctx kvmContext) Start(domainName string, domainID int) error {
 	return nil
 }
 
+// Stop asks the guest agent (if there is one) to shut the guest down and falls
+// back to an ACPI powerdown; when forced the device model simply goes away
 func (ctx kvmContext) Stop(domainName string, domainID int, force bool) error {
+	if force {
+		execStop(getQmpExecutorSocket(domainName))
+		if err := execQuit(getQmpExecutorSocket(domainName)); err != nil {
+			return logError("Stop: failed to execute quit command %v", err)
+		}
+		return nil
+	}
+	if qgaSocket, found := getQgaSocket(domainName); found {
+		err := qgaShutdown(qgaSocket)
+		if err == nil {
+			return nil
+		}
+		logrus.Warnf("Stop: guest agent shutdown of %s failed, using ACPI: %v", domainName, err)
+	}
 	if err := execShutdown(getQmpExecutorSocket(domainName)); err != nil {
 		return logError("Stop: failed to execute shutdown command %v", err)
 	}
@@ -723,6 +843,237 @@ func (ctx kvmContext) Delete(domainName string, domainID int) (result error) {
 	return nil
 }
 
+// Snapshot uses savevm which stores the device and memory state together with
+// internal snapshots of all the disks, hence requires every disk to be qcow2.
+// With a guest agent the guest filesystems are frozen for the duration.
+func (ctx kvmContext) Snapshot(domainName string, domainID int, snapshotName string) error {
+	if qgaSocket, found := getQgaSocket(domainName); found {
+		if _, err := qgaFsFreeze(qgaSocket); err != nil {
+			logrus.Warnf("Snapshot: couldn't freeze filesystems of %s: %v", domainName, err)
+		}
+		defer thawGuest(domainName)
+	}
+	if err := execSaveVM(getQmpExecutorSocket(domainName), snapshotName); err != nil {
+		return logError("failed to save snapshot %s of domain %s: %v", snapshotName, domainName, err)
+	}
+	return nil
+}
+
+func (ctx kvmContext) Restore(domainName string, domainID int, snapshotName string) error {
+	if err := execLoadVM(getQmpExecutorSocket(domainName), snapshotName); err != nil {
+		return logError("failed to restore snapshot %s of domain %s: %v", snapshotName, domainName, err)
+	}
+	// the snapshot was taken with the guest filesystems frozen
+	thawGuest(domainName)
+	return nil
+}
+
+func thawGuest(domainName string) {
+	if qgaSocket, found := getQgaSocket(domainName); found {
+		if _, err := qgaFsThaw(qgaSocket); err != nil {
+			logrus.Errorf("couldn't thaw filesystems of %s: %v", domainName, err)
+		}
+	}
+}
+
+func (ctx kvmContext) SecurityProfile(domainName string) (*types.EffectiveSecurityProfile, error) {
+	// the task of a VM is the hypervisor itself
+	return nil, nil
+}
+
+// Console connects to the socket of the chardev of the serial console of
+// the domain which qemu serves one client at a time. qemu also writes the
+// output to its stdout, which goes to the logs of the domain from the start.
+func (ctx kvmContext) Console(domainName string, domainID int) (io.ReadWriteCloser, bool, error) {
+	conn, err := net.Dial("unix", getConsoleSocket(domainName))
+	if err != nil {
+		return nil, false, logError("can't attach to the console of %s: %v", domainName, err)
+	}
+	return conn, true, nil
+}
+
+func (ctx kvmContext) PodContainers(domainName string) ([]types.PodContainerStatus, error) {
+	return nil, nil
+}
+
+func (ctx kvmContext) GuestInfo(domainName string, domainID int) (*types.GuestInfo, error) {
+	qgaSocket, found := getQgaSocket(domainName)
+	if !found {
+		return nil, nil
+	}
+	return qgaGuestInfo(qgaSocket)
+}
+
+func (ctx kvmContext) SetMemory(domainName string, domainID int, memory int) error {
+	if err := execBalloon(getQmpExecutorSocket(domainName), int64(memory)*1024); err != nil {
+		return logError("failed to balloon domain %s to %d kbytes: %v", domainName, memory, err)
+	}
+	return nil
+}
+
+// deviceAddHotplug plugs the device into the first free hot-plug root port
+func deviceAddHotplug(socket string, args map[string]interface{}) error {
+	var err error
+	for i := 0; i < kvmHotplugPorts; i++ {
+		args["bus"] = fmt.Sprintf("hotplug%d", i)
+		if err = execDeviceAdd(socket, args); err == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+// waitUnplugged retries the removal of the backend of a device which
+// fails until the guest has released the device
+func waitUnplugged(remove func() error) error {
+	var err error
+	for i := 0; i < kvmUnplugRetries; i++ {
+		if err = remove(); err == nil {
+			return nil
+		}
+		time.Sleep(time.Second)
+	}
+	return err
+}
+
+func (ctx kvmContext) AttachDisk(domainName string, domainID int, disk types.DiskStatus) error {
+	if disk.Devtype != "hdd" {
+		return logError("can't hot-plug %s disk %s into domain %s", disk.Devtype, disk.Vdev, domainName)
+	}
+	socket := getQmpExecutorSocket(domainName)
+	node := "drive-hotplug-" + disk.Vdev
+	if err := execBlockdevAdd(socket, node, disk.FileLocation,
+		strings.ToLower(disk.Format.String()), disk.ReadOnly); err != nil {
+		return logError("failed to add %s to domain %s: %v", disk.FileLocation, domainName, err)
+	}
+	args := map[string]interface{}{
+		"driver": "virtio-blk-pci",
+		"id":     "hotplug-" + disk.Vdev,
+		"drive":  node,
+	}
+	if err := deviceAddHotplug(socket, args); err != nil {
+		if err := execBlockdevDel(socket, node); err != nil {
+			logrus.Errorf("failed to remove %s from domain %s: %v", node, domainName, err)
+		}
+		return logError("failed to hot-plug disk %s into domain %s: %v", disk.Vdev, domainName, err)
+	}
+	return nil
+}
+
+func (ctx kvmContext) DetachDisk(domainName string, domainID int, disk types.DiskStatus) error {
+	socket := getQmpExecutorSocket(domainName)
+	if err := execDeviceDel(socket, "hotplug-"+disk.Vdev); err != nil {
+		return logError("failed to unplug disk %s from domain %s: %v", disk.Vdev, domainName, err)
+	}
+	if err := waitUnplugged(func() error { return execBlockdevDel(socket, "drive-hotplug-"+disk.Vdev) }); err != nil {
+		return logError("domain %s didn't release disk %s: %v", domainName, disk.Vdev, err)
+	}
+	return nil
+}
+
+func (ctx kvmContext) AttachVif(domainName string, domainID int, vif types.VifInfo) error {
+	socket := getQmpExecutorSocket(domainName)
+	netdev := "hostnet-hotplug-" + vif.Vif
+	// same as qemuNetTemplate
+	netdevArgs := map[string]interface{}{
+		"type":       "tap",
+		"id":         netdev,
+		"ifname":     vif.Vif,
+		"br":         vif.Bridge,
+		"script":     "/etc/xen/scripts/qemu-ifup",
+		"downscript": "no",
+	}
+	if err := execNetdevAdd(socket, netdevArgs); err != nil {
+		return logError("failed to add vif %s to domain %s: %v", vif.Vif, domainName, err)
+	}
+	args := map[string]interface{}{
+		"driver": "virtio-net-pci",
+		"id":     "net-hotplug-" + vif.Vif,
+		"netdev": netdev,
+		"mac":    vif.Mac,
+	}
+	if err := deviceAddHotplug(socket, args); err != nil {
+		if err := execNetdevDel(socket, netdev); err != nil {
+			logrus.Errorf("failed to remove %s from domain %s: %v", netdev, domainName, err)
+		}
+		return logError("failed to hot-plug vif %s into domain %s: %v", vif.Vif, domainName, err)
+	}
+	return nil
+}
+
+func (ctx kvmContext) DetachVif(domainName string, domainID int, vif types.VifInfo) error {
+	socket := getQmpExecutorSocket(domainName)
+	if err := execDeviceDel(socket, "net-hotplug-"+vif.Vif); err != nil {
+		return logError("failed to unplug vif %s from domain %s: %v", vif.Vif, domainName, err)
+	}
+	if err := waitUnplugged(func() error { return execNetdevDel(socket, "hostnet-hotplug-"+vif.Vif) }); err != nil {
+		return logError("domain %s didn't release vif %s: %v", domainName, vif.Vif, err)
+	}
+	return nil
+}
+
+// AttachUsb plugs the USB device of the host into the xHCI controller of the domain
+func (ctx kvmContext) AttachUsb(domainName string, domainID int, usbAddr string) error {
+	bus, addr := usbBusPort(usbAddr)
+	hostbus, err := strconv.Atoi(bus)
+	if err != nil {
+		return logError("bad USB address %s for domain %s", usbAddr, domainName)
+	}
+	hostaddr, err := strconv.Atoi(addr)
+	if err != nil {
+		return logError("bad USB address %s for domain %s", usbAddr, domainName)
+	}
+	// same as qemuUsbHostTemplate
+	args := map[string]interface{}{
+		"driver":   "usb-host",
+		"id":       usbDeviceID(usbAddr),
+		"bus":      "usb.0",
+		"hostbus":  hostbus,
+		"hostaddr": hostaddr,
+	}
+	if err := execDeviceAdd(getQmpExecutorSocket(domainName), args); err != nil {
+		return logError("failed to hot-plug USB device %s into domain %s: %v", usbAddr, domainName, err)
+	}
+	return nil
+}
+
+func (ctx kvmContext) DetachUsb(domainName string, domainID int, usbAddr string) error {
+	if err := execDeviceDel(getQmpExecutorSocket(domainName), usbDeviceID(usbAddr)); err != nil {
+		return logError("failed to unplug USB device %s from domain %s: %v", usbAddr, domainName, err)
+	}
+	return nil
+}
+
+// usbDeviceID turns a UsbAddr into a valid QMP id. USB devices assigned at
+// boot get the same id as hot-plugged ones so DetachUsb finds either.
+func usbDeviceID(usbAddr string) string {
+	return "usb-host-" + strings.NewReplacer(":", "-", ".", "_").Replace(usbAddr)
+}
+
+// GetDomsCPUMem reports for the domains with a balloon what they currently have
+func (ctx kvmContext) GetDomsCPUMem() (map[string]types.DomainMetric, error) {
+	res, err := ctx.ctrdContext.GetDomsCPUMem()
+	if err != nil {
+		return res, err
+	}
+	for domainName, dm := range res {
+		socket := getQmpExecutorSocket(domainName)
+		if _, err := os.Stat(socket); err != nil {
+			continue
+		}
+		// domains without a balloon device fail this; keep what the cgroup says
+		if actual, err := getBalloonActual(socket); err == nil {
+			dm.AllocatedMB = uint32(roundFromBytesToMbytes(uint64(actual)))
+			res[domainName] = dm
+		}
+	}
+	return res, nil
+}
+
+func (ctx kvmContext) DomainEvents() <-chan types.DomainEvent {
+	return ctx.events
+}
+
 func (ctx kvmContext) Info(domainName string, domainID int) (int, types.SwState, error) {
 	// first we ask for the task status
 	effectiveDomainID, effectiveDomainState, err := ctx.ctrdContext.Info(domainName, domainID)
@@ -734,6 +1085,8 @@ func (ctx kvmContext) Info(domainName string, domainID int) (int, types.SwState,
 	// lets parse the status according to https://github.com/qemu/qemu/blob/master/qapi/run-state.json#L8
 	stateMap := map[string]types.SwState{
 		"finish-migrate": types.PAUSED,
+		"guest-panicked": types.BROKEN,
+		"io-error":       types.PAUSED,
 		"inmigrate":      types.PAUSING,
 		"paused":         types.PAUSED,
 		"postmigrate":    types.PAUSED,
@@ -842,3 +1195,20 @@ func getQmpExecutorSocket(domainName string) string {
 func getQmpListenerSocket(domainName string) string {
 	return kvmStateDir + domainName + "/listener.qmp"
 }
+
+func getSwtpmSocket(domainName string) string {
+	return kvmStateDir + domainName + "/swtpm"
+}
+
+func getConsoleSocket(domainName string) string {
+	return kvmStateDir + domainName + "/cons"
+}
+
+// getQgaSocket returns the guest agent socket of a domain if it was created with one
+func getQgaSocket(domainName string) (string, bool) {
+	socket := kvmStateDir + domainName + "/qga"
+	if _, err := os.Stat(socket); err != nil {
+		return "", false
+	}
+	return socket, true
+}
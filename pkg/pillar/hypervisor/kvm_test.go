@@ -4,6 +4,7 @@ import (
 	"io/ioutil"
 	"os"
 	"os/exec"
+	"strings"
 	"testing"
 
 	zconfig "github.com/lf-edge/eve/api/go/config"
@@ -338,6 +339,34 @@ func TestCreateDomConfigOnlyCom1(t *testing.T) {
   bus = "pci.8"
   addr = "0x0"
 
+[device "hotplug0"]
+  driver = "pcie-root-port"
+  port = "19"
+  chassis = "9"
+  bus = "pcie.0"
+  addr = "9"
+
+[device "hotplug1"]
+  driver = "pcie-root-port"
+  port = "110"
+  chassis = "10"
+  bus = "pcie.0"
+  addr = "10"
+
+[device "hotplug2"]
+  driver = "pcie-root-port"
+  port = "111"
+  chassis = "11"
+  bus = "pcie.0"
+  addr = "11"
+
+[device "hotplug3"]
+  driver = "pcie-root-port"
+  port = "112"
+  chassis = "12"
+  bus = "pcie.0"
+  addr = "12"
+
 [chardev "charserial-usr0"]
   backend = "tty"
   path = "/dev/ttyS0"
@@ -600,6 +629,34 @@ func TestCreateDomConfigOnlyCom1(t *testing.T) {
   bus = "pci.8"
   addr = "0x0"
 
+[device "hotplug0"]
+  driver = "pcie-root-port"
+  port = "19"
+  chassis = "9"
+  bus = "pcie.0"
+  addr = "9"
+
+[device "hotplug1"]
+  driver = "pcie-root-port"
+  port = "110"
+  chassis = "10"
+  bus = "pcie.0"
+  addr = "10"
+
+[device "hotplug2"]
+  driver = "pcie-root-port"
+  port = "111"
+  chassis = "11"
+  bus = "pcie.0"
+  addr = "11"
+
+[device "hotplug3"]
+  driver = "pcie-root-port"
+  port = "112"
+  chassis = "12"
+  bus = "pcie.0"
+  addr = "12"
+
 [chardev "charserial-usr0"]
   backend = "tty"
   path = "/dev/ttyS0"
@@ -840,6 +897,34 @@ func TestCreateDomConfigOnlyCom1(t *testing.T) {
   bus = "pci.8"
   addr = "0x0"
 
+[device "hotplug0"]
+  driver = "pcie-root-port"
+  port = "19"
+  chassis = "9"
+  bus = "pcie.0"
+  addr = "9"
+
+[device "hotplug1"]
+  driver = "pcie-root-port"
+  port = "110"
+  chassis = "10"
+  bus = "pcie.0"
+  addr = "10"
+
+[device "hotplug2"]
+  driver = "pcie-root-port"
+  port = "111"
+  chassis = "11"
+  bus = "pcie.0"
+  addr = "11"
+
+[device "hotplug3"]
+  driver = "pcie-root-port"
+  port = "112"
+  chassis = "12"
+  bus = "pcie.0"
+  addr = "12"
+
 [chardev "charserial-usr0"]
   backend = "tty"
   path = "/dev/ttyS0"
@@ -1174,6 +1259,34 @@ func TestCreateDomConfig(t *testing.T) {
   bus = "pci.8"
   addr = "0x0"
 
+[device "hotplug0"]
+  driver = "pcie-root-port"
+  port = "19"
+  chassis = "9"
+  bus = "pcie.0"
+  addr = "9"
+
+[device "hotplug1"]
+  driver = "pcie-root-port"
+  port = "110"
+  chassis = "10"
+  bus = "pcie.0"
+  addr = "10"
+
+[device "hotplug2"]
+  driver = "pcie-root-port"
+  port = "111"
+  chassis = "11"
+  bus = "pcie.0"
+  addr = "11"
+
+[device "hotplug3"]
+  driver = "pcie-root-port"
+  port = "112"
+  chassis = "12"
+  bus = "pcie.0"
+  addr = "12"
+
 [device]
   driver = "vfio-pci"
   host = "03:00.0"
@@ -1185,7 +1298,7 @@ func TestCreateDomConfig(t *testing.T) {
   driver = "isa-serial"
   chardev = "charserial-usr0"
 
-[device]
+[device "usb-host-1-1"]
   driver = "usb-host"
   hostbus = "1"
   hostaddr = "1"
@@ -1447,6 +1560,34 @@ func TestCreateDomConfig(t *testing.T) {
   bus = "pci.8"
   addr = "0x0"
 
+[device "hotplug0"]
+  driver = "pcie-root-port"
+  port = "19"
+  chassis = "9"
+  bus = "pcie.0"
+  addr = "9"
+
+[device "hotplug1"]
+  driver = "pcie-root-port"
+  port = "110"
+  chassis = "10"
+  bus = "pcie.0"
+  addr = "10"
+
+[device "hotplug2"]
+  driver = "pcie-root-port"
+  port = "111"
+  chassis = "11"
+  bus = "pcie.0"
+  addr = "11"
+
+[device "hotplug3"]
+  driver = "pcie-root-port"
+  port = "112"
+  chassis = "12"
+  bus = "pcie.0"
+  addr = "12"
+
 [device]
   driver = "vfio-pci"
   host = "03:00.0"
@@ -1458,7 +1599,7 @@ func TestCreateDomConfig(t *testing.T) {
   driver = "isa-serial"
   chardev = "charserial-usr0"
 
-[device]
+[device "usb-host-1-1"]
   driver = "usb-host"
   hostbus = "1"
   hostaddr = "1"
@@ -1717,6 +1858,34 @@ func TestCreateDomConfig(t *testing.T) {
   bus = "pci.8"
   addr = "0x0"
 
+[device "hotplug0"]
+  driver = "pcie-root-port"
+  port = "19"
+  chassis = "9"
+  bus = "pcie.0"
+  addr = "9"
+
+[device "hotplug1"]
+  driver = "pcie-root-port"
+  port = "110"
+  chassis = "10"
+  bus = "pcie.0"
+  addr = "10"
+
+[device "hotplug2"]
+  driver = "pcie-root-port"
+  port = "111"
+  chassis = "11"
+  bus = "pcie.0"
+  addr = "11"
+
+[device "hotplug3"]
+  driver = "pcie-root-port"
+  port = "112"
+  chassis = "12"
+  bus = "pcie.0"
+  addr = "12"
+
 [device]
   driver = "vfio-pci"
   host = "03:00.0"
@@ -1728,7 +1897,7 @@ func TestCreateDomConfig(t *testing.T) {
   driver = "isa-serial"
   chardev = "charserial-usr0"
 
-[device]
+[device "usb-host-1-1"]
   driver = "usb-host"
   hostbus = "1"
   hostaddr = "1"
@@ -1965,6 +2134,34 @@ func TestCreateDomConfig(t *testing.T) {
   bus = "pci.8"
   addr = "0x0"
 
+[device "hotplug0"]
+  driver = "pcie-root-port"
+  port = "19"
+  chassis = "9"
+  bus = "pcie.0"
+  addr = "9"
+
+[device "hotplug1"]
+  driver = "pcie-root-port"
+  port = "110"
+  chassis = "10"
+  bus = "pcie.0"
+  addr = "10"
+
+[device "hotplug2"]
+  driver = "pcie-root-port"
+  port = "111"
+  chassis = "11"
+  bus = "pcie.0"
+  addr = "11"
+
+[device "hotplug3"]
+  driver = "pcie-root-port"
+  port = "112"
+  chassis = "12"
+  bus = "pcie.0"
+  addr = "12"
+
 [device]
   driver = "vfio-pci"
   host = "03:00.0"
@@ -1976,7 +2173,7 @@ func TestCreateDomConfig(t *testing.T) {
   driver = "isa-serial"
   chardev = "charserial-usr0"
 
-[device]
+[device "usb-host-1-1"]
   driver = "usb-host"
   hostbus = "1"
   hostaddr = "1"
@@ -1986,6 +2183,52 @@ func TestCreateDomConfig(t *testing.T) {
 	})
 }
 
+func TestCreateDomConfigVTPM(t *testing.T) {
+	initTest(t)
+	config := types.DomainConfig{
+		UUIDandVersion: types.UUIDandVersion{UUID: uuid.NewV4(), Version: "1.0"},
+		VmConfig:       types.VmConfig{Memory: 1024 * 1024, VCpus: 1, EnableVTPM: true},
+	}
+	testMatrix := map[string]struct {
+		ctx    kvmContext
+		driver string
+	}{
+		"amd64": {ctx: kvmIntel, driver: "tpm-crb"},
+		"arm64": {ctx: kvmArm, driver: "tpm-tis-device"},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		conf, err := ioutil.TempFile("/tmp", "config")
+		if err != nil {
+			t.Fatalf("Can't create config file for a domain %v", err)
+		}
+		defer os.Remove(conf.Name())
+		if err := test.ctx.CreateDomConfig("test", config, nil, &types.AssignableAdapters{}, conf); err != nil {
+			t.Errorf("CreateDomConfig failed %v", err)
+		}
+		result, err := ioutil.ReadFile(conf.Name())
+		if err != nil {
+			t.Errorf("reading conf file failed %v", err)
+		}
+		expected := `
+[chardev "chartpm"]
+  backend = "socket"
+  path = "/run/hypervisor/kvm/test/swtpm"
+
+[tpmdev "tpm0"]
+  type = "emulator"
+  chardev = "chartpm"
+
+[device "tpm"]
+  driver = "` + test.driver + `"
+  tpmdev = "tpm0"
+`
+		if !strings.Contains(string(result), expected) {
+			t.Errorf("%s: no TPM in the resulting config %s", testname, string(result))
+		}
+	}
+}
+
 func TestCreateDom(t *testing.T) {
 	initTest(t)
 	if exec.Command("qemu-system-x86_64", "--version").Run() != nil {
@@ -0,0 +1,654 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package hypervisor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/eriknordmark/netlink"
+	zconfig "github.com/lf-edge/eve/api/go/config"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+)
+
+// The microvm hypervisor runs domains with a minimal VMM (cloud-hypervisor)
+// which has no device emulation to speak of: just virtio devices, a serial
+// port and a virtio console. Hence it boots in a fraction of the time qemu
+// takes and is a good fit for isolating container images in their own VM.
+// Just like with kvm the VMM runs in a containerd task (xen-tools) but
+// instead of a config file it is configured through the REST API it serves
+// on a UNIX domain socket
+//     https://github.com/cloud-hypervisor/cloud-hypervisor/blob/master/vmm/src/api/openapi/cloud-hypervisor.yaml
+// The rootfs of container images is shared with virtio-fs by a virtiofsd
+// running alongside the VMM in the same task.
+
+var microvmStateDir = "/run/hypervisor/microvm/"
+
+// microvmStartScript runs virtiofsd (if needed) and the VMM in the task
+const microvmStartScript = "/etc/xen/scripts/microvm-start"
+
+// microvmOverHead is the memory the VMM and virtiofsd need on top of the domain
+const microvmOverHead = int64(100 * 1024 * 1024)
+
+// microvmAPITimeout is how long we wait for the VMM to serve its API
+const microvmAPITimeout = 10 * time.Second
+
+// microvmShareTag is the tag of the virtio-fs rootfs of container images
+// which is what the runx initrd looks for (just like with 9P)
+const microvmShareTag = "share_dir"
+
+// microvmStateMap maps the states of the VM as reported by vm.info
+var microvmStateMap = map[string]types.SwState{
+	"Created":    types.PAUSED,
+	"Running":    types.RUNNING,
+	"Paused":     types.PAUSED,
+	"Shutdown":   types.HALTING,
+	"BreakPoint": types.PAUSED,
+}
+
+type microvmContext struct {
+	ctrdContext
+}
+
+func newMicrovm() Hypervisor {
+	ctrdCtx, err := initContainerd()
+	if err != nil {
+		logrus.Fatalf("couldn't initialize containerd (this should not happen): %v. Exiting.", err)
+		return nil // it really never returns on account of above
+	}
+	return microvmContext{ctrdContext: *ctrdCtx}
+}
+
+// the VM configuration as understood by vm.create
+
+type microvmCpus struct {
+	BootVcpus int `json:"boot_vcpus"`
+	MaxVcpus  int `json:"max_vcpus"`
+}
+
+type microvmMemory struct {
+	Size   int64 `json:"size"`
+	Shared bool  `json:"shared,omitempty"`
+}
+
+type microvmPath struct {
+	Path string `json:"path"`
+}
+
+type microvmCmdline struct {
+	Args string `json:"args"`
+}
+
+type microvmDisk struct {
+	ID       string `json:"id,omitempty"`
+	Path     string `json:"path"`
+	Readonly bool   `json:"readonly,omitempty"`
+}
+
+type microvmNet struct {
+	ID  string `json:"id,omitempty"`
+	Tap string `json:"tap"`
+	Mac string `json:"mac,omitempty"`
+}
+
+type microvmFs struct {
+	Tag       string `json:"tag"`
+	Socket    string `json:"socket"`
+	NumQueues int    `json:"num_queues"`
+	QueueSize int    `json:"queue_size"`
+}
+
+type microvmBalloon struct {
+	Size int64 `json:"size"`
+}
+
+type microvmConsole struct {
+	Mode string `json:"mode"`
+	File string `json:"file,omitempty"`
+}
+
+type microvmConfig struct {
+	Cpus      microvmCpus     `json:"cpus"`
+	Memory    microvmMemory   `json:"memory"`
+	Kernel    microvmPath     `json:"kernel"`
+	Initramfs *microvmPath    `json:"initramfs,omitempty"`
+	Cmdline   microvmCmdline  `json:"cmdline"`
+	Disks     []microvmDisk   `json:"disks,omitempty"`
+	Net       []microvmNet    `json:"net,omitempty"`
+	Fs        []microvmFs     `json:"fs,omitempty"`
+	Balloon   *microvmBalloon `json:"balloon,omitempty"`
+	Serial    microvmConsole  `json:"serial"`
+	Console   microvmConsole  `json:"console"`
+}
+
+type microvmInfo struct {
+	Config microvmConfig `json:"config"`
+	State  string        `json:"state"`
+}
+
+// microvmDomain is what Setup leaves in the state directory for Start
+type microvmDomain struct {
+	Config microvmConfig
+	// Bridges of the taps of the VM which are created by the VMM
+	Bridges map[string]string
+	// ShareDir is the rootfs of a container image
+	ShareDir string
+}
+
+// microvmAPI is a client of the REST API of the VMM
+type microvmAPI struct {
+	client http.Client
+}
+
+func newMicrovmAPI(socket string) *microvmAPI {
+	return &microvmAPI{client: http.Client{
+		Timeout: microvmAPITimeout,
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, "unix", socket)
+			},
+		},
+	}}
+}
+
+// call sends args (unless nil) as JSON to the endpoint and decodes what it
+// returns into result (unless nil)
+func (api *microvmAPI) call(method string, endpoint string, args interface{}, result interface{}) error {
+	var body io.Reader
+	if args != nil {
+		data, err := json.Marshal(args)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+	req, err := http.NewRequest(method, "http://localhost/api/v1/"+endpoint, body)
+	if err != nil {
+		return err
+	}
+	if args != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := api.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	reply, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("%s %s: %s: %s", method, endpoint, resp.Status,
+			strings.TrimSpace(string(reply)))
+	}
+	if result != nil {
+		if err := json.Unmarshal(reply, result); err != nil {
+			return fmt.Errorf("%s %s: %v", method, endpoint, err)
+		}
+	}
+	return nil
+}
+
+func (api *microvmAPI) put(endpoint string, args interface{}) error {
+	return api.call(http.MethodPut, endpoint, args, nil)
+}
+
+func (api *microvmAPI) info() (*microvmInfo, error) {
+	var info microvmInfo
+	if err := api.call(http.MethodGet, "vm.info", nil, &info); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+// wait returns once the VMM serves its API
+func (api *microvmAPI) wait() error {
+	delay := 100 * time.Millisecond
+	deadline := time.Now().Add(microvmAPITimeout)
+	for {
+		err := api.call(http.MethodGet, "vmm.ping", nil, nil)
+		if err == nil {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return err
+		}
+		time.Sleep(delay)
+	}
+}
+
+// boot creates the VM and boots it
+func (api *microvmAPI) boot(config microvmConfig) error {
+	if err := api.wait(); err != nil {
+		return fmt.Errorf("VMM not there: %v", err)
+	}
+	if err := api.put("vm.create", config); err != nil {
+		return err
+	}
+	return api.put("vm.boot", nil)
+}
+
+// microvmDomConfig translates the config of a domain to the VM config of
+// the VMM; disks are expected to be in a format the VMM understands
+func microvmDomConfig(domainName string, config types.DomainConfig,
+	diskStatusList []types.DiskStatus) (*microvmDomain, error) {
+
+	dom := &microvmDomain{Bridges: make(map[string]string)}
+	vm := &dom.Config
+	vm.Cpus.BootVcpus = config.VCpus
+	if vm.Cpus.BootVcpus == 0 {
+		vm.Cpus.BootVcpus = 1
+	}
+	vm.Cpus.MaxVcpus = config.MaxCpus
+	if vm.Cpus.MaxVcpus < vm.Cpus.BootVcpus {
+		vm.Cpus.MaxVcpus = vm.Cpus.BootVcpus
+	}
+	// just like with kvm the VM starts out with MaxMem and domainmgr
+	// inflates the balloon down to Memory once the domain runs
+	vm.Memory.Size = int64(config.MaxMemOrDefault()) * 1024
+	vm.Balloon = &microvmBalloon{}
+
+	// there is no firmware but a bootloader can be booted as a kernel
+	switch {
+	case config.Kernel != "":
+		vm.Kernel.Path = config.Kernel
+	case config.BootLoader != "":
+		vm.Kernel.Path = config.BootLoader
+	default:
+		return nil, fmt.Errorf("domain %s has neither a kernel nor a bootloader", domainName)
+	}
+	if config.Ramdisk != "" {
+		vm.Initramfs = &microvmPath{Path: config.Ramdisk}
+	}
+	vm.Cmdline.Args = config.ExtraArgs
+	// the console of the domain is hvc0, with the serial port as a fallback
+	vm.Console = microvmConsole{Mode: "Pty"}
+	vm.Serial = microvmConsole{Mode: "Null"}
+
+	for _, ds := range diskStatusList {
+		switch ds.Devtype {
+		case "":
+		case "9P":
+			// the VMM has no 9P, we share the rootfs with virtio-fs instead
+			dom.ShareDir = ds.FileLocation
+			vm.Fs = append(vm.Fs, microvmFs{
+				Tag:       microvmShareTag,
+				Socket:    getMicrovmFsSocket(domainName),
+				NumQueues: 1,
+				QueueSize: 1024,
+			})
+			vm.Memory.Shared = true
+			vm.Cmdline.Args = strings.Replace(vm.Cmdline.Args, "root=9p", "root=virtiofs", 1)
+		case "hdd", "cdrom":
+			// a cloud-init ISO is as good a read-only disk as any
+			if ds.Format != zconfig.Format_RAW && ds.Format != zconfig.Format_QCOW2 {
+				return nil, fmt.Errorf("disk %s of domain %s is %s which is not supported",
+					ds.Vdev, domainName, ds.Format)
+			}
+			vm.Disks = append(vm.Disks, microvmDisk{
+				ID:       ds.Vdev,
+				Path:     ds.FileLocation,
+				Readonly: ds.ReadOnly || ds.Devtype == "cdrom",
+			})
+		default:
+			return nil, fmt.Errorf("%s disk %s of domain %s is not supported",
+				ds.Devtype, ds.Vdev, domainName)
+		}
+	}
+	for _, vif := range config.VifList {
+		vm.Net = append(vm.Net, microvmNet{ID: vif.Vif, Tap: vif.Vif, Mac: vif.Mac})
+		dom.Bridges[vif.Vif] = vif.Bridge
+	}
+	return dom, nil
+}
+
+// bridgeTap adds a tap created by the VMM to its bridge
+func bridgeTap(tap string, bridge string) error {
+	link, err := netlink.LinkByName(tap)
+	if err != nil {
+		return fmt.Errorf("no tap %s: %v", tap, err)
+	}
+	bridgeLink, err := netlink.LinkByName(bridge)
+	if err != nil {
+		return fmt.Errorf("no bridge %s: %v", bridge, err)
+	}
+	br, ok := bridgeLink.(*netlink.Bridge)
+	if !ok {
+		return fmt.Errorf("%s is not a bridge", bridge)
+	}
+	if err := netlink.LinkSetMaster(link, br); err != nil {
+		return err
+	}
+	return netlink.LinkSetUp(link)
+}
+
+func (ctx microvmContext) Name() string {
+	return "microvm"
+}
+
+func (ctx microvmContext) Task(status *types.DomainStatus) types.Task {
+	if status.VirtualizationMode == types.NOHYPER {
+		return ctx.ctrdContext
+	}
+	return ctx
+}
+
+func (ctx microvmContext) GetCapabilities() (*types.Capabilities, error) {
+	return &types.Capabilities{
+		HWAssistedVirtualization: true,
+		IOVirtualization:         false,
+	}, nil
+}
+
+func (ctx microvmContext) Setup(status types.DomainStatus, config types.DomainConfig, aa *types.AssignableAdapters, file *os.File) error {
+	domainName := status.DomainName
+	if status.IsPod() {
+		return logError("failed to run domain %s: pods only run as containers", domainName)
+	}
+	if len(config.IoAdapterList) != 0 {
+		return logError("failed to run domain %s: no device assignment with microvm", domainName)
+	}
+	if config.VirtualizationMode == types.LEGACY || config.VirtualizationMode == types.FML {
+		return logError("failed to run domain %s: microvm only runs virtio guests", domainName)
+	}
+	dom, err := microvmDomConfig(domainName, config, status.DiskStatusList)
+	if err != nil {
+		return logError("failed to build domain config: %v", err)
+	}
+	data, err := json.MarshalIndent(dom, "", "  ")
+	if err != nil {
+		return logError("failed to build domain config: %v", err)
+	}
+	if _, err := file.Write(data); err != nil {
+		return logError("can't write to config file %s (%v)", file.Name(), err)
+	}
+	if err := os.MkdirAll(microvmStateDir+domainName, 0777); err != nil {
+		return logError("failed to create state directory of domain %s: %v", domainName, err)
+	}
+	if err := ioutil.WriteFile(getMicrovmDomain(domainName), data, 0644); err != nil {
+		return logError("failed to save the config of domain %s: %v", domainName, err)
+	}
+
+	args := []string{microvmStartScript, domainName, getMicrovmAPISocket(domainName)}
+	if dom.ShareDir != "" {
+		args = append(args, getMicrovmFsSocket(domainName), dom.ShareDir)
+	}
+
+	spec, err := ctx.setupSpec(&status, &config, status.OCIConfigDir)
+	if err != nil {
+		return logError("failed to load OCI spec for domain %s: %v", domainName, err)
+	}
+	if err = spec.AddLoader("/containers/services/xen-tools"); err != nil {
+		return logError("failed to add microvm hypervisor loader to domain %s: %v", domainName, err)
+	}
+	memConfig := config
+	memConfig.Memory = config.MaxMemOrDefault()
+	spec.AdjustMemLimit(memConfig, microvmOverHead)
+	pinCPUs(spec, config)
+	spec.Get().Process.Args = args
+	logrus.Infof("Hypervisor args: %v", args)
+
+	if err := spec.CreateContainer(true); err != nil {
+		return logError("Failed to create container for task %s from %v: %v", domainName, config, err)
+	}
+	return nil
+}
+
+func (ctx microvmContext) Start(domainName string, domainID int) error {
+	logrus.Infof("starting microvm domain %s", domainName)
+	data, err := ioutil.ReadFile(getMicrovmDomain(domainName))
+	if err != nil {
+		return logError("no config for domain %s: %v", domainName, err)
+	}
+	var dom microvmDomain
+	if err := json.Unmarshal(data, &dom); err != nil {
+		return logError("bad config for domain %s: %v", domainName, err)
+	}
+	if err := ctx.ctrdContext.Start(domainName, domainID); err != nil {
+		logrus.Errorf("couldn't start task for domain %s: %v", domainName, err)
+		return err
+	}
+	if err := newMicrovmAPI(getMicrovmAPISocket(domainName)).boot(dom.Config); err != nil {
+		return logError("failed to boot domain %s: %v", domainName, err)
+	}
+	for tap, bridge := range dom.Bridges {
+		if err := bridgeTap(tap, bridge); err != nil {
+			return logError("failed to connect vif %s of domain %s: %v", tap, domainName, err)
+		}
+	}
+	return nil
+}
+
+// Stop presses the power button of the VM; when forced the VMM simply goes away
+func (ctx microvmContext) Stop(domainName string, domainID int, force bool) error {
+	api := newMicrovmAPI(getMicrovmAPISocket(domainName))
+	if force {
+		if err := api.put("vmm.shutdown", nil); err != nil {
+			return logError("Stop: failed to shut the VMM of %s down: %v", domainName, err)
+		}
+		return nil
+	}
+	if err := api.put("vm.power-button", nil); err != nil {
+		return logError("Stop: failed to power domain %s off: %v", domainName, err)
+	}
+	return nil
+}
+
+func (ctx microvmContext) Delete(domainName string, domainID int) (result error) {
+	// regardless of happens to everything else, we have to try and delete the task
+	defer func() {
+		if err := ctx.ctrdContext.Delete(domainName, domainID); err != nil {
+			result = fmt.Errorf("%w; couldn't delete task %s: %v", result, domainName, err)
+		}
+	}()
+
+	// the VMM may well be gone already
+	if err := newMicrovmAPI(getMicrovmAPISocket(domainName)).put("vmm.shutdown", nil); err != nil {
+		logrus.Infof("Delete: VMM of %s didn't shut down: %v", domainName, err)
+	}
+	if err := os.RemoveAll(microvmStateDir + domainName); err != nil {
+		return logError("failed to clean up domain state directory %s (%v)", domainName, err)
+	}
+	return nil
+}
+
+func (ctx microvmContext) Info(domainName string, domainID int) (int, types.SwState, error) {
+	effectiveDomainID, effectiveDomainState, err := ctx.ctrdContext.Info(domainName, domainID)
+	if err != nil || effectiveDomainState != types.RUNNING {
+		return effectiveDomainID, effectiveDomainState, err
+	}
+	state, err := microvmState(getMicrovmAPISocket(domainName))
+	if err != nil {
+		return effectiveDomainID, types.BROKEN, logError("couldn't retrieve status for domain %s: %v", domainName, err)
+	}
+	return effectiveDomainID, state, nil
+}
+
+func microvmState(socket string) (types.SwState, error) {
+	info, err := newMicrovmAPI(socket).info()
+	if err != nil {
+		return types.BROKEN, err
+	}
+	state, found := microvmStateMap[info.State]
+	if !found {
+		return types.BROKEN, fmt.Errorf("unexpected state %s", info.State)
+	}
+	return state, nil
+}
+
+// Snapshot isn't supported since the snapshots of the VMM are of the
+// memory and devices only; it relies on the disks being taken care of
+func (ctx microvmContext) Snapshot(domainName string, domainID int, snapshotName string) error {
+	return logError("snapshots of domain %s are not supported by microvm", domainName)
+}
+
+func (ctx microvmContext) Restore(domainName string, domainID int, snapshotName string) error {
+	return logError("snapshots of domain %s are not supported by microvm", domainName)
+}
+
+func (ctx microvmContext) GuestInfo(domainName string, domainID int) (*types.GuestInfo, error) {
+	return nil, nil
+}
+
+func (ctx microvmContext) SecurityProfile(domainName string) (*types.EffectiveSecurityProfile, error) {
+	// the task of a VM is the hypervisor itself
+	return nil, nil
+}
+
+func (ctx microvmContext) PodContainers(domainName string) ([]types.PodContainerStatus, error) {
+	return nil, nil
+}
+
+// Console opens the pty the VMM created for the virtio console of the domain
+func (ctx microvmContext) Console(domainName string, domainID int) (io.ReadWriteCloser, bool, error) {
+	console, err := microvmConsolePty(getMicrovmAPISocket(domainName))
+	if err != nil {
+		return nil, false, logError("can't attach to the console of %s: %v", domainName, err)
+	}
+	return console, false, nil
+}
+
+func microvmConsolePty(socket string) (*os.File, error) {
+	info, err := newMicrovmAPI(socket).info()
+	if err != nil {
+		return nil, err
+	}
+	if info.Config.Console.File == "" {
+		return nil, fmt.Errorf("no console pty")
+	}
+	pty, err := os.OpenFile(info.Config.Console.File, os.O_RDWR|unix.O_NOCTTY, 0)
+	if err != nil {
+		return nil, err
+	}
+	// we are a terminal emulator, not a line discipline
+	if err := makeRaw(int(pty.Fd())); err != nil {
+		pty.Close()
+		return nil, err
+	}
+	return pty, nil
+}
+
+// makeRaw is cfmakeraw(3)
+func makeRaw(fd int) error {
+	termios, err := unix.IoctlGetTermios(fd, unix.TCGETS)
+	if err != nil {
+		return err
+	}
+	termios.Iflag &^= unix.IGNBRK | unix.BRKINT | unix.PARMRK | unix.ISTRIP |
+		unix.INLCR | unix.IGNCR | unix.ICRNL | unix.IXON
+	termios.Oflag &^= unix.OPOST
+	termios.Lflag &^= unix.ECHO | unix.ECHONL | unix.ICANON | unix.ISIG | unix.IEXTEN
+	termios.Cflag &^= unix.CSIZE | unix.PARENB
+	termios.Cflag |= unix.CS8
+	termios.Cc[unix.VMIN] = 1
+	termios.Cc[unix.VTIME] = 0
+	return unix.IoctlSetTermios(fd, unix.TCSETS, termios)
+}
+
+// SetMemory inflates the balloon by what the domain has above memory
+func (ctx microvmContext) SetMemory(domainName string, domainID int, memory int) error {
+	if err := microvmSetBalloon(getMicrovmAPISocket(domainName), int64(memory)*1024); err != nil {
+		return logError("failed to balloon domain %s to %d kbytes: %v", domainName, memory, err)
+	}
+	return nil
+}
+
+func microvmSetBalloon(socket string, memory int64) error {
+	api := newMicrovmAPI(socket)
+	info, err := api.info()
+	if err != nil {
+		return err
+	}
+	if info.Config.Balloon == nil {
+		return fmt.Errorf("no balloon")
+	}
+	if memory > info.Config.Memory.Size {
+		return fmt.Errorf("domain only has %d bytes", info.Config.Memory.Size)
+	}
+	return api.put("vm.resize", struct {
+		DesiredBalloon int64 `json:"desired_balloon"`
+	}{DesiredBalloon: info.Config.Memory.Size - memory})
+}
+
+func (ctx microvmContext) AttachDisk(domainName string, domainID int, disk types.DiskStatus) error {
+	if disk.Devtype != "hdd" {
+		return logError("can't hot-plug %s disk %s into domain %s", disk.Devtype, disk.Vdev, domainName)
+	}
+	args := microvmDisk{ID: "hotplug-" + disk.Vdev, Path: disk.FileLocation, Readonly: disk.ReadOnly}
+	if err := newMicrovmAPI(getMicrovmAPISocket(domainName)).put("vm.add-disk", args); err != nil {
+		return logError("failed to hot-plug disk %s into domain %s: %v", disk.Vdev, domainName, err)
+	}
+	return nil
+}
+
+func (ctx microvmContext) DetachDisk(domainName string, domainID int, disk types.DiskStatus) error {
+	if err := microvmRemoveDevice(getMicrovmAPISocket(domainName), "hotplug-"+disk.Vdev); err != nil {
+		return logError("failed to unplug disk %s from domain %s: %v", disk.Vdev, domainName, err)
+	}
+	return nil
+}
+
+func (ctx microvmContext) AttachVif(domainName string, domainID int, vif types.VifInfo) error {
+	args := microvmNet{ID: "net-hotplug-" + vif.Vif, Tap: vif.Vif, Mac: vif.Mac}
+	if err := newMicrovmAPI(getMicrovmAPISocket(domainName)).put("vm.add-net", args); err != nil {
+		return logError("failed to hot-plug vif %s into domain %s: %v", vif.Vif, domainName, err)
+	}
+	if err := bridgeTap(vif.Vif, vif.Bridge); err != nil {
+		if err := microvmRemoveDevice(getMicrovmAPISocket(domainName), args.ID); err != nil {
+			logrus.Errorf("failed to remove %s from domain %s: %v", args.ID, domainName, err)
+		}
+		return logError("failed to connect vif %s of domain %s: %v", vif.Vif, domainName, err)
+	}
+	return nil
+}
+
+func (ctx microvmContext) DetachVif(domainName string, domainID int, vif types.VifInfo) error {
+	if err := microvmRemoveDevice(getMicrovmAPISocket(domainName), "net-hotplug-"+vif.Vif); err != nil {
+		return logError("failed to unplug vif %s from domain %s: %v", vif.Vif, domainName, err)
+	}
+	return nil
+}
+
+func microvmRemoveDevice(socket string, id string) error {
+	return newMicrovmAPI(socket).put("vm.remove-device", struct {
+		ID string `json:"id"`
+	}{ID: id})
+}
+
+func (ctx microvmContext) PCIReserve(long string) error {
+	return logError("PCI device %s can't be assigned with microvm", long)
+}
+
+func (ctx microvmContext) PCIRelease(long string) error {
+	return logError("PCI device %s can't be assigned with microvm", long)
+}
+
+func (ctx microvmContext) DomainEvents() <-chan types.DomainEvent {
+	return nil
+}
+
+func getMicrovmAPISocket(domainName string) string {
+	return microvmStateDir + domainName + "/api"
+}
+
+func getMicrovmFsSocket(domainName string) string {
+	return microvmStateDir + domainName + "/virtiofs"
+}
+
+func getMicrovmDomain(domainName string) string {
+	return microvmStateDir + domainName + "/domain.json"
+}
@@ -0,0 +1,319 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package hypervisor
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	zconfig "github.com/lf-edge/eve/api/go/config"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/sys/unix"
+)
+
+// fakeVMM serves the subset of the API of the VMM we use and keeps track
+// of what the VM would look like
+type fakeVMM struct {
+	sync.Mutex
+	created  bool
+	state    string
+	config   microvmConfig
+	balloon  int64
+	devices  map[string]bool
+	consPath string
+}
+
+func (f *fakeVMM) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.Lock()
+	defer f.Unlock()
+	fail := func(code int, format string, a ...interface{}) {
+		w.WriteHeader(code)
+		fmt.Fprintf(w, format, a...)
+	}
+	endpoint := strings.TrimPrefix(r.URL.Path, "/api/v1/")
+	if endpoint != "vmm.ping" && endpoint != "vm.create" && !f.created {
+		fail(http.StatusInternalServerError, "VM is not created")
+		return
+	}
+	switch endpoint {
+	case "vmm.ping":
+		fmt.Fprint(w, `{"version": "fake"}`)
+	case "vm.create":
+		if err := json.NewDecoder(r.Body).Decode(&f.config); err != nil {
+			fail(http.StatusBadRequest, "%v", err)
+			return
+		}
+		f.created = true
+		f.state = "Created"
+		f.config.Console.File = f.consPath
+		w.WriteHeader(http.StatusNoContent)
+	case "vm.boot":
+		f.state = "Running"
+		w.WriteHeader(http.StatusNoContent)
+	case "vm.power-button":
+		f.state = "Shutdown"
+		w.WriteHeader(http.StatusNoContent)
+	case "vm.info":
+		json.NewEncoder(w).Encode(microvmInfo{Config: f.config, State: f.state})
+	case "vm.resize":
+		var args struct {
+			DesiredBalloon int64 `json:"desired_balloon"`
+		}
+		json.NewDecoder(r.Body).Decode(&args)
+		f.balloon = args.DesiredBalloon
+		w.WriteHeader(http.StatusNoContent)
+	case "vm.add-disk", "vm.add-net":
+		var args struct {
+			ID string `json:"id"`
+		}
+		json.NewDecoder(r.Body).Decode(&args)
+		f.devices[args.ID] = true
+		w.WriteHeader(http.StatusNoContent)
+	case "vm.remove-device":
+		var args struct {
+			ID string `json:"id"`
+		}
+		json.NewDecoder(r.Body).Decode(&args)
+		if !f.devices[args.ID] {
+			fail(http.StatusInternalServerError, "no device %s", args.ID)
+			return
+		}
+		delete(f.devices, args.ID)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		fail(http.StatusNotFound, "unknown endpoint %s", endpoint)
+	}
+}
+
+func serveFakeVMM(t *testing.T, socket string, vmm *fakeVMM) func() {
+	l, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Fatalf("can't listen on %s: %v", socket, err)
+	}
+	server := &http.Server{Handler: vmm}
+	go server.Serve(l)
+	return func() { server.Close() }
+}
+
+func TestMicrovmAPI(t *testing.T) {
+	dir, err := ioutil.TempDir("", "microvm")
+	if err != nil {
+		t.Fatalf("can't create temp dir %v", err)
+	}
+	defer os.RemoveAll(dir)
+	socket := filepath.Join(dir, "api")
+	vmm := &fakeVMM{devices: make(map[string]bool)}
+	defer serveFakeVMM(t, socket, vmm)()
+	api := newMicrovmAPI(socket)
+
+	if _, err := microvmState(socket); err == nil {
+		t.Errorf("microvmState succeeded before the VM was created")
+	}
+	config := microvmConfig{
+		Memory:  microvmMemory{Size: 512 << 20},
+		Balloon: &microvmBalloon{},
+	}
+	if err := api.boot(config); err != nil {
+		t.Fatalf("boot failed: %v", err)
+	}
+	if state, err := microvmState(socket); err != nil || state != types.RUNNING {
+		t.Errorf("got state %v (%v) expected running", state, err)
+	}
+
+	if err := microvmSetBalloon(socket, 256<<20); err != nil || vmm.balloon != 256<<20 {
+		t.Errorf("balloon is %d (%v) expected %d", vmm.balloon, err, 256<<20)
+	}
+	if err := microvmSetBalloon(socket, 1<<30); err == nil {
+		t.Errorf("ballooning above the size of the VM succeeded")
+	}
+
+	if err := api.put("vm.add-disk", microvmDisk{ID: "hotplug-xvdb", Path: "/dev/null"}); err != nil {
+		t.Errorf("vm.add-disk failed: %v", err)
+	}
+	if err := microvmRemoveDevice(socket, "hotplug-xvdb"); err != nil {
+		t.Errorf("removing disk failed: %v", err)
+	}
+	if err := microvmRemoveDevice(socket, "hotplug-xvdb"); err == nil ||
+		!strings.Contains(err.Error(), "no device hotplug-xvdb") {
+		t.Errorf("removing a missing disk returned %v", err)
+	}
+
+	if err := api.put("vm.power-button", nil); err != nil {
+		t.Errorf("vm.power-button failed: %v", err)
+	}
+	if state, err := microvmState(socket); err != nil || state != types.HALTING {
+		t.Errorf("got state %v (%v) expected halting", state, err)
+	}
+	if err := api.put("vm.snapshot", nil); err == nil {
+		t.Errorf("unknown endpoint succeeded")
+	}
+}
+
+// openPty returns the master of a new pty and the path of its slave
+func openPty(t *testing.T) (*os.File, string) {
+	master, err := os.OpenFile("/dev/ptmx", os.O_RDWR|unix.O_NOCTTY, 0)
+	if err != nil {
+		t.Skipf("no ptys: %v", err)
+	}
+	if err := unix.IoctlSetPointerInt(int(master.Fd()), unix.TIOCSPTLCK, 0); err != nil {
+		master.Close()
+		t.Skipf("can't unlock pty: %v", err)
+	}
+	n, err := unix.IoctlGetInt(int(master.Fd()), unix.TIOCGPTN)
+	if err != nil {
+		master.Close()
+		t.Skipf("no pty number: %v", err)
+	}
+	return master, fmt.Sprintf("/dev/pts/%d", n)
+}
+
+func TestMicrovmConsole(t *testing.T) {
+	master, slave := openPty(t)
+	defer master.Close()
+	dir, err := ioutil.TempDir("", "microvm")
+	if err != nil {
+		t.Fatalf("can't create temp dir %v", err)
+	}
+	defer os.RemoveAll(dir)
+	socket := filepath.Join(dir, "api")
+	vmm := &fakeVMM{devices: make(map[string]bool), consPath: slave}
+	defer serveFakeVMM(t, socket, vmm)()
+	if err := newMicrovmAPI(socket).boot(microvmConfig{}); err != nil {
+		t.Fatalf("boot failed: %v", err)
+	}
+
+	console, err := microvmConsolePty(socket)
+	if err != nil {
+		t.Fatalf("microvmConsolePty failed: %v", err)
+	}
+	defer console.Close()
+	// what the guest writes comes out unchanged
+	master.Write([]byte("login: \r\n"))
+	buf := make([]byte, 9)
+	if _, err := io.ReadFull(console, buf); err != nil || string(buf) != "login: \r\n" {
+		t.Errorf("console got %q (%v)", buf, err)
+	}
+	// and what we write isn't echoed back
+	console.Write([]byte("root\n"))
+	buf = make([]byte, 5)
+	if _, err := io.ReadFull(master, buf); err != nil || string(buf) != "root\n" {
+		t.Errorf("guest got %q (%v)", buf, err)
+	}
+}
+
+func TestMicrovmDomConfig(t *testing.T) {
+	testMatrix := map[string]struct {
+		config   types.DomainConfig
+		disks    []types.DiskStatus
+		expected microvmDomain
+		fail     bool
+	}{
+		"container": {
+			config: types.DomainConfig{
+				VmConfig: types.VmConfig{
+					Kernel:    "/hostfs/boot/kernel",
+					Ramdisk:   "/usr/lib/xen/boot/runx-initrd",
+					ExtraArgs: "console=hvc0 root=9p dhcp=1",
+					Memory:    262144,
+					VCpus:     2,
+				},
+				VifList: []types.VifInfo{{Vif: "nbu1x1", Bridge: "bn1", Mac: "00:16:3e:00:01:01"}},
+			},
+			disks: []types.DiskStatus{
+				{FileLocation: "/persist/vault/volumes/container", MountDir: "/"},
+				{FileLocation: "/mnt", Devtype: "9P"},
+			},
+			expected: microvmDomain{
+				Config: microvmConfig{
+					Cpus:      microvmCpus{BootVcpus: 2, MaxVcpus: 2},
+					Memory:    microvmMemory{Size: 256 << 20, Shared: true},
+					Kernel:    microvmPath{Path: "/hostfs/boot/kernel"},
+					Initramfs: &microvmPath{Path: "/usr/lib/xen/boot/runx-initrd"},
+					Cmdline:   microvmCmdline{Args: "console=hvc0 root=virtiofs dhcp=1"},
+					Net:       []microvmNet{{ID: "nbu1x1", Tap: "nbu1x1", Mac: "00:16:3e:00:01:01"}},
+					Fs: []microvmFs{{
+						Tag:       "share_dir",
+						Socket:    "/run/hypervisor/microvm/test/virtiofs",
+						NumQueues: 1,
+						QueueSize: 1024,
+					}},
+					Balloon: &microvmBalloon{},
+					Serial:  microvmConsole{Mode: "Null"},
+					Console: microvmConsole{Mode: "Pty"},
+				},
+				Bridges:  map[string]string{"nbu1x1": "bn1"},
+				ShareDir: "/mnt",
+			},
+		},
+		"vm": {
+			config: types.DomainConfig{
+				VmConfig: types.VmConfig{
+					BootLoader: "/usr/lib/xen/boot/hypervisor-fw",
+					Memory:     524288,
+					MaxMem:     1048576,
+					VCpus:      1,
+					MaxCpus:    4,
+				},
+			},
+			disks: []types.DiskStatus{
+				{FileLocation: "/persist/img/disk.qcow2", Devtype: "hdd", Vdev: "xvda", Format: zconfig.Format_QCOW2},
+				{FileLocation: "/persist/img/cloud-init.iso", Devtype: "cdrom", Vdev: "xvdz", Format: zconfig.Format_RAW},
+			},
+			expected: microvmDomain{
+				Config: microvmConfig{
+					Cpus:   microvmCpus{BootVcpus: 1, MaxVcpus: 4},
+					Memory: microvmMemory{Size: 1 << 30},
+					Kernel: microvmPath{Path: "/usr/lib/xen/boot/hypervisor-fw"},
+					Disks: []microvmDisk{
+						{ID: "xvda", Path: "/persist/img/disk.qcow2"},
+						{ID: "xvdz", Path: "/persist/img/cloud-init.iso", Readonly: true},
+					},
+					Balloon: &microvmBalloon{},
+					Serial:  microvmConsole{Mode: "Null"},
+					Console: microvmConsole{Mode: "Pty"},
+				},
+				Bridges: map[string]string{},
+			},
+		},
+		"no kernel": {
+			config: types.DomainConfig{VmConfig: types.VmConfig{Memory: 262144}},
+			fail:   true,
+		},
+		"vmdk disk": {
+			config: types.DomainConfig{VmConfig: types.VmConfig{Kernel: "/kernel", Memory: 262144}},
+			disks: []types.DiskStatus{
+				{FileLocation: "/persist/img/disk.vmdk", Devtype: "hdd", Vdev: "xvda", Format: zconfig.Format_VMDK},
+			},
+			fail: true,
+		},
+		"legacy disk": {
+			config: types.DomainConfig{VmConfig: types.VmConfig{Kernel: "/kernel", Memory: 262144}},
+			disks: []types.DiskStatus{
+				{FileLocation: "/persist/img/disk.raw", Devtype: "legacy", Vdev: "xvda", Format: zconfig.Format_RAW},
+			},
+			fail: true,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		dom, err := microvmDomConfig("test", test.config, test.disks)
+		if (err != nil) != test.fail {
+			t.Errorf("%s: unexpected error %v", testname, err)
+		}
+		if test.fail {
+			continue
+		}
+		assert.Equal(t, test.expected, *dom, testname)
+	}
+}
@@ -6,6 +6,7 @@ package hypervisor
 import (
 	"fmt"
 	"github.com/lf-edge/eve/pkg/pillar/types"
+	"io"
 	"io/ioutil"
 	"os"
 
@@ -13,9 +14,11 @@ import (
 )
 
 type domState struct {
-	id     int
-	config string
-	state  types.SwState
+	id        int
+	config    string
+	state     types.SwState
+	snapshots map[string]types.SwState
+	devices   map[string]bool // Hot-plugged disks and vifs
 }
 
 type nullContext struct {
@@ -82,7 +85,8 @@ func (ctx nullContext) Create(domainName string, cfgFilename string, config *typ
 
 	// calls to Create are serialized in the consumer: no need to worry about locking
 	ctx.domCounter++
-	ctx.doms[domainName] = &domState{id: ctx.domCounter, config: string(configContent), state: types.HALTED}
+	ctx.doms[domainName] = &domState{id: ctx.domCounter, config: string(configContent), state: types.HALTED,
+		snapshots: map[string]types.SwState{}, devices: map[string]bool{}}
 
 	return ctx.domCounter, nil
 }
@@ -122,6 +126,123 @@ func (ctx nullContext) Info(domainName string, domainID int) (int, types.SwState
 	}
 }
 
+func (ctx nullContext) Snapshot(domainName string, domainID int, snapshotName string) error {
+	if dom, found := ctx.doms[domainName]; found && (dom.state == types.RUNNING || dom.state == types.PAUSED) {
+		dom.snapshots[snapshotName] = dom.state
+		return nil
+	} else {
+		return fmt.Errorf("null domain %s doesn't exist or is not running", domainName)
+	}
+}
+
+func (ctx nullContext) Restore(domainName string, domainID int, snapshotName string) error {
+	dom, found := ctx.doms[domainName]
+	if !found {
+		return fmt.Errorf("null domain %s doesn't exist", domainName)
+	}
+	if state, found := dom.snapshots[snapshotName]; found {
+		dom.state = state
+		return nil
+	} else {
+		return fmt.Errorf("null domain %s doesn't have snapshot %s", domainName, snapshotName)
+	}
+}
+
+func (ctx nullContext) GuestInfo(domainName string, domainID int) (*types.GuestInfo, error) {
+	return nil, nil
+}
+
+func (ctx nullContext) SecurityProfile(domainName string) (*types.EffectiveSecurityProfile, error) {
+	return nil, nil
+}
+
+func (ctx nullContext) Console(domainName string, domainID int) (io.ReadWriteCloser, bool, error) {
+	return nil, false, nil
+}
+
+func (ctx nullContext) PodContainers(domainName string) ([]types.PodContainerStatus, error) {
+	return nil, nil
+}
+
+func (ctx nullContext) SetMemory(domainName string, domainID int, memory int) error {
+	if _, found := ctx.doms[domainName]; !found {
+		return fmt.Errorf("null domain %s doesn't exist", domainName)
+	}
+	return nil
+}
+
+func (ctx nullContext) AttachDisk(domainName string, domainID int, disk types.DiskStatus) error {
+	dom, found := ctx.doms[domainName]
+	if !found {
+		return fmt.Errorf("null domain %s doesn't exist", domainName)
+	}
+	if dom.devices[disk.Vdev] {
+		return fmt.Errorf("null domain %s already has disk %s", domainName, disk.Vdev)
+	}
+	dom.devices[disk.Vdev] = true
+	return nil
+}
+
+func (ctx nullContext) DetachDisk(domainName string, domainID int, disk types.DiskStatus) error {
+	dom, found := ctx.doms[domainName]
+	if !found {
+		return fmt.Errorf("null domain %s doesn't exist", domainName)
+	}
+	if !dom.devices[disk.Vdev] {
+		return fmt.Errorf("null domain %s doesn't have disk %s", domainName, disk.Vdev)
+	}
+	delete(dom.devices, disk.Vdev)
+	return nil
+}
+
+func (ctx nullContext) AttachVif(domainName string, domainID int, vif types.VifInfo) error {
+	dom, found := ctx.doms[domainName]
+	if !found {
+		return fmt.Errorf("null domain %s doesn't exist", domainName)
+	}
+	if dom.devices[vif.Vif] {
+		return fmt.Errorf("null domain %s already has vif %s", domainName, vif.Vif)
+	}
+	dom.devices[vif.Vif] = true
+	return nil
+}
+
+func (ctx nullContext) DetachVif(domainName string, domainID int, vif types.VifInfo) error {
+	dom, found := ctx.doms[domainName]
+	if !found {
+		return fmt.Errorf("null domain %s doesn't exist", domainName)
+	}
+	if !dom.devices[vif.Vif] {
+		return fmt.Errorf("null domain %s doesn't have vif %s", domainName, vif.Vif)
+	}
+	delete(dom.devices, vif.Vif)
+	return nil
+}
+
+func (ctx nullContext) AttachUsb(domainName string, domainID int, usbAddr string) error {
+	dom, found := ctx.doms[domainName]
+	if !found {
+		return fmt.Errorf("null domain %s doesn't exist", domainName)
+	}
+	if dom.devices["usb-"+usbAddr] {
+		return fmt.Errorf("null domain %s already has USB device %s", domainName, usbAddr)
+	}
+	dom.devices["usb-"+usbAddr] = true
+	return nil
+}
+
+func (ctx nullContext) DetachUsb(domainName string, domainID int, usbAddr string) error {
+	dom, found := ctx.doms[domainName]
+	if !found {
+		return fmt.Errorf("null domain %s doesn't exist", domainName)
+	}
+	if !dom.devices["usb-"+usbAddr] {
+		return fmt.Errorf("null domain %s doesn't have USB device %s", domainName, usbAddr)
+	}
+	delete(dom.devices, "usb-"+usbAddr)
+	return nil
+}
+
 func (ctx nullContext) PCIReserve(long string) error {
 	if ctx.PCI[long] {
 		return fmt.Errorf("PCI %s is already reserved", long)
@@ -147,3 +268,7 @@ func (ctx nullContext) GetHostCPUMem() (types.HostMemory, error) {
 func (ctx nullContext) GetDomsCPUMem() (map[string]types.DomainMetric, error) {
 	return nil, nil
 }
+
+func (ctx nullContext) DomainEvents() <-chan types.DomainEvent {
+	return nil
+}
@@ -98,6 +98,40 @@ serial = ['pty']
 		t.Errorf("Start domain should've failed for a domain that is already running")
 	}
 
+	if err := hyper.Task(testDom).Restore("test.1", domID, "snap1"); err == nil {
+		t.Errorf("Restore domain should've failed for a snapshot that doesn't exist")
+	}
+
+	if err := hyper.Task(testDom).Snapshot("test.1", domID, "snap1"); err != nil {
+		t.Errorf("Couldn't snapshot a domain %v", err)
+	}
+
+	if err := hyper.Task(testDom).Restore("test.1", domID, "snap1"); err != nil {
+		t.Errorf("Couldn't restore a domain %v", err)
+	}
+
+	disk := types.DiskStatus{Vdev: "xvdb"}
+	if err := hyper.Task(testDom).AttachDisk("test.1", domID, disk); err != nil {
+		t.Errorf("Couldn't attach a disk %v", err)
+	}
+
+	if err := hyper.Task(testDom).AttachDisk("test.1", domID, disk); err == nil {
+		t.Errorf("AttachDisk should've failed for a disk that is already attached")
+	}
+
+	if err := hyper.Task(testDom).DetachDisk("test.1", domID, disk); err != nil {
+		t.Errorf("Couldn't detach a disk %v", err)
+	}
+
+	vif := types.VifInfo{Vif: "nbu1x1"}
+	if err := hyper.Task(testDom).DetachVif("test.1", domID, vif); err == nil {
+		t.Errorf("DetachVif should've failed for a vif that isn't attached")
+	}
+
+	if err := hyper.Task(testDom).AttachVif("test.1", domID, vif); err != nil {
+		t.Errorf("Couldn't attach a vif %v", err)
+	}
+
 	if err := hyper.Task(testDom).Stop("test.1", domID, false); err != nil {
 		t.Errorf("Couldn't stop a domain %v", err)
 	}
@@ -0,0 +1,172 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package hypervisor
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net"
+	"time"
+
+	"github.com/digitalocean/go-qemu/qmp"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/sirupsen/logrus"
+)
+
+// this file implements a subset of the qemu-guest-agent protocol
+//     https://qemu.weilnetz.de/doc/qemu-ga-ref.html
+// The agent is reached through a virtio-serial port which qemu exposes
+// on the host as a UNIX domain socket. Unlike QMP there is no greeting,
+// the agent may not be running at all and replies to commands we gave
+// up on may still be queued up; hence every exchange starts with guest-sync.
+
+const qgaTimeout = 5 * time.Second
+
+type qgaResponse struct {
+	Return json.RawMessage `json:"return"`
+	Error  *struct {
+		Class string `json:"class"`
+		Desc  string `json:"desc"`
+	} `json:"error"`
+}
+
+// execQga runs a guest agent command and decodes what it returns into
+// result (unless result is nil). If noReply is set it doesn't wait for a
+// reply, which is what some commands like guest-shutdown do on success.
+func execQga(socket string, command string, args interface{}, result interface{}, noReply bool) error {
+	logrus.Debugf("executing guest agent command %s on %s", command, socket)
+	conn, err := net.DialTimeout("unix", socket, qgaTimeout)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if err := conn.SetDeadline(time.Now().Add(qgaTimeout)); err != nil {
+		return err
+	}
+	enc := json.NewEncoder(conn)
+	dec := json.NewDecoder(conn)
+
+	// skip whatever is left over from earlier exchanges until we get our id back
+	id := rand.Int63()
+	syncArgs := struct {
+		ID int64 `json:"id"`
+	}{ID: id}
+	if err := enc.Encode(qmp.Command{Execute: "guest-sync", Args: syncArgs}); err != nil {
+		return err
+	}
+	for {
+		var resp qgaResponse
+		if err := dec.Decode(&resp); err != nil {
+			return fmt.Errorf("guest-sync: %w", err)
+		}
+		var got int64
+		if resp.Error == nil && json.Unmarshal(resp.Return, &got) == nil && got == id {
+			break
+		}
+	}
+
+	if err := enc.Encode(qmp.Command{Execute: command, Args: args}); err != nil {
+		return err
+	}
+	if noReply {
+		return nil
+	}
+	var resp qgaResponse
+	if err := dec.Decode(&resp); err != nil {
+		return fmt.Errorf("%s: %w", command, err)
+	}
+	if resp.Error != nil {
+		return fmt.Errorf("%s: %s", command, resp.Error.Desc)
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(resp.Return, result)
+}
+
+func qgaPing(socket string) error {
+	return execQga(socket, "guest-ping", nil, nil, false)
+}
+
+// qgaShutdown asks the guest OS to power off; the agent only replies if that fails
+func qgaShutdown(socket string) error {
+	args := struct {
+		Mode string `json:"mode"`
+	}{Mode: "powerdown"}
+	return execQga(socket, "guest-shutdown", args, nil, true)
+}
+
+// qgaFsFreeze freezes all the guest filesystems, returns how many were frozen
+func qgaFsFreeze(socket string) (int, error) {
+	var frozen int
+	err := execQga(socket, "guest-fsfreeze-freeze", nil, &frozen, false)
+	return frozen, err
+}
+
+// qgaFsThaw thaws all the guest filesystems, returns how many were thawed
+func qgaFsThaw(socket string) (int, error) {
+	var thawed int
+	err := execQga(socket, "guest-fsfreeze-thaw", nil, &thawed, false)
+	return thawed, err
+}
+
+// qgaOSInfo is what guest-get-osinfo returns
+type qgaOSInfo struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	PrettyName    string `json:"pretty-name"`
+	Version       string `json:"version"`
+	KernelRelease string `json:"kernel-release"`
+	Machine       string `json:"machine"`
+}
+
+// qgaInterface is an element of what guest-network-get-interfaces returns
+type qgaInterface struct {
+	Name            string `json:"name"`
+	HardwareAddress string `json:"hardware-address"`
+	IPAddresses     []struct {
+		Type    string `json:"ip-address-type"`
+		Address string `json:"ip-address"`
+		Prefix  int    `json:"prefix"`
+	} `json:"ip-addresses"`
+}
+
+// qgaGuestInfo pings the agent and, if it is there, collects what the guest
+// reports about itself. Failing to get the details is not an error since
+// older agents don't implement all the commands.
+func qgaGuestInfo(socket string) (*types.GuestInfo, error) {
+	info := &types.GuestInfo{}
+	if err := qgaPing(socket); err != nil {
+		return info, err
+	}
+	info.Responsive = true
+	info.LastSeen = time.Now()
+
+	var osInfo qgaOSInfo
+	if err := execQga(socket, "guest-get-osinfo", nil, &osInfo, false); err != nil {
+		logrus.Warnf("qgaGuestInfo: no OS info from %s: %v", socket, err)
+	} else {
+		info.OSName = osInfo.PrettyName
+		if info.OSName == "" {
+			info.OSName = osInfo.Name + " " + osInfo.Version
+		}
+		info.KernelRelease = osInfo.KernelRelease
+	}
+
+	var ifs []qgaInterface
+	if err := execQga(socket, "guest-network-get-interfaces", nil, &ifs, false); err != nil {
+		logrus.Warnf("qgaGuestInfo: no interfaces from %s: %v", socket, err)
+	} else {
+		for _, i := range ifs {
+			if i.Name == "lo" {
+				continue
+			}
+			for _, addr := range i.IPAddresses {
+				info.IPAddrs = append(info.IPAddrs, addr.Address)
+			}
+		}
+	}
+	return info, nil
+}
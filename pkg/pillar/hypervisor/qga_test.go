@@ -0,0 +1,132 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package hypervisor
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// fakeQga serves a guest agent socket. Each connection first gets a stale
+// reply (as if left over from an earlier command) and commands it knows
+// nothing about fail. Commands received are reported on cmds.
+func fakeQga(t *testing.T, socket string, cmds chan<- string) net.Listener {
+	l, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Fatalf("can't listen on %s: %v", socket, err)
+	}
+	replies := map[string]string{
+		"guest-ping":                   `{}`,
+		"guest-fsfreeze-freeze":        `2`,
+		"guest-fsfreeze-thaw":          `2`,
+		"guest-get-osinfo":             `{"id": "alpine", "name": "Alpine Linux", "pretty-name": "Alpine Linux v3.12", "version": "3.12.3", "kernel-release": "5.4.84"}`,
+		"guest-network-get-interfaces": `[{"name": "lo", "ip-addresses": [{"ip-address-type": "ipv4", "ip-address": "127.0.0.1", "prefix": 8}]}, {"name": "eth0", "hardware-address": "00:16:3e:00:01:01", "ip-addresses": [{"ip-address-type": "ipv4", "ip-address": "10.1.0.2", "prefix": 24}, {"ip-address-type": "ipv6", "ip-address": "fe80::216:3eff:fe00:101", "prefix": 64}]}]`,
+	}
+	go func() {
+		for {
+			c, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func(c net.Conn) {
+				defer c.Close()
+				fmt.Fprintln(c, `{"return": 42}`)
+				dec := json.NewDecoder(c)
+				for {
+					var cmd struct {
+						Execute string          `json:"execute"`
+						Args    json.RawMessage `json:"arguments"`
+					}
+					if err := dec.Decode(&cmd); err != nil {
+						return
+					}
+					cmds <- cmd.Execute
+					if cmd.Execute == "guest-sync" {
+						var args struct {
+							ID int64 `json:"id"`
+						}
+						json.Unmarshal(cmd.Args, &args)
+						fmt.Fprintf(c, "{\"return\": %d}\n", args.ID)
+					} else if cmd.Execute == "guest-shutdown" {
+						// no reply on success
+					} else if reply, found := replies[cmd.Execute]; found {
+						fmt.Fprintf(c, "{\"return\": %s}\n", reply)
+					} else {
+						fmt.Fprintf(c, "{\"error\": {\"class\": \"CommandNotFound\", \"desc\": \"The command %s has not been found\"}}\n", cmd.Execute)
+					}
+				}
+			}(c)
+		}
+	}()
+	return l
+}
+
+func TestQgaCommands(t *testing.T) {
+	dir, err := ioutil.TempDir("", "qga")
+	if err != nil {
+		t.Fatalf("can't create temp dir %v", err)
+	}
+	defer os.RemoveAll(dir)
+	socket := filepath.Join(dir, "qga")
+
+	cmds := make(chan string, 100)
+	l := fakeQga(t, socket, cmds)
+	defer l.Close()
+
+	if err := qgaPing(socket); err != nil {
+		t.Errorf("qgaPing failed %v", err)
+	}
+	if frozen, err := qgaFsFreeze(socket); err != nil || frozen != 2 {
+		t.Errorf("qgaFsFreeze returned %d, %v", frozen, err)
+	}
+	if err := execQga(socket, "guest-exec", nil, nil, false); err == nil {
+		t.Errorf("guest-exec should've failed since fake agent doesn't know it")
+	}
+	if err := qgaShutdown(socket); err != nil {
+		t.Errorf("qgaShutdown failed %v", err)
+	}
+
+	info, err := qgaGuestInfo(socket)
+	if err != nil {
+		t.Fatalf("qgaGuestInfo failed %v", err)
+	}
+	if !info.Responsive || info.LastSeen.IsZero() {
+		t.Errorf("qgaGuestInfo should've reported a responsive agent: %+v", info)
+	}
+	if info.OSName != "Alpine Linux v3.12" || info.KernelRelease != "5.4.84" {
+		t.Errorf("qgaGuestInfo reported wrong OS: %+v", info)
+	}
+	if len(info.IPAddrs) != 2 || info.IPAddrs[0] != "10.1.0.2" {
+		t.Errorf("qgaGuestInfo reported wrong IP addresses: %v", info.IPAddrs)
+	}
+
+	close(cmds)
+	syncs := 0
+	for cmd := range cmds {
+		if cmd == "guest-sync" {
+			syncs++
+		}
+	}
+	if syncs != 7 {
+		t.Errorf("expected every command to be preceded by guest-sync, got %d syncs", syncs)
+	}
+}
+
+func TestQgaNoAgent(t *testing.T) {
+	dir, err := ioutil.TempDir("", "qga")
+	if err != nil {
+		t.Fatalf("can't create temp dir %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	info, err := qgaGuestInfo(filepath.Join(dir, "qga"))
+	if err == nil || info == nil || info.Responsive {
+		t.Errorf("qgaGuestInfo should've reported an unresponsive agent, got %+v, %v", info, err)
+	}
+}
@@ -3,10 +3,12 @@ package hypervisor
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
+
 	"github.com/digitalocean/go-qemu/qmp"
+	"github.com/lf-edge/eve/pkg/pillar/types"
 	"github.com/sirupsen/logrus"
-	"os"
-	"time"
 )
 
 // this package implements subset of
@@ -14,6 +16,9 @@ import (
 
 const sockTimeout = 10 * time.Second
 
+// execRawCmd connects to the socket for each command. The monitor
+// chardev only serves one client at a time hence we must not keep the
+// connection open between commands; events come in on the listener socket.
 func execRawCmd(socket, cmd string) ([]byte, error) {
 	var retry = 3
 	logrus.Debugf("executing QMP command: %s", cmd)
@@ -40,50 +45,183 @@ func execRawCmd(socket, cmd string) ([]byte, error) {
 	return monitor.Run([]byte(cmd))
 }
 
+// execute runs a QMP command with (optional) arguments and decodes
+// what it returns into result (unless result is nil)
+func execute(socket, command string, args interface{}, result interface{}) error {
+	cmd, err := json.Marshal(qmp.Command{Execute: command, Args: args})
+	if err != nil {
+		return err
+	}
+	raw, err := execRawCmd(socket, string(cmd))
+	if err != nil {
+		return fmt.Errorf("%s: %w", command, err)
+	}
+	if result == nil {
+		return nil
+	}
+	reply := struct {
+		Return interface{} `json:"return"`
+	}{Return: result}
+	return json.Unmarshal(raw, &reply)
+}
+
 func execContinue(socket string) error {
-	_, err := execRawCmd(socket, `{ "execute": "cont" }`)
-	return err
+	return execute(socket, "cont", nil, nil)
 }
 
 func execStop(socket string) error {
-	_, err := execRawCmd(socket, `{ "execute": "stop" }`)
-	return err
+	return execute(socket, "stop", nil, nil)
 }
 
 func execShutdown(socket string) error {
-	_, err := execRawCmd(socket, `{ "execute": "system_powerdown" }`)
-	return err
+	return execute(socket, "system_powerdown", nil, nil)
 }
 
 func execQuit(socket string) error {
-	_, err := execRawCmd(socket, `{ "execute": "quit" }`)
-	return err
+	return execute(socket, "quit", nil, nil)
 }
 
 func execVNCPassword(socket string, password string) error {
-	vncSetPwd := fmt.Sprintf(`{ "execute": "change-vnc-password", "arguments": { "password": "%s" } }`, password)
-	_, err := execRawCmd(socket, vncSetPwd)
-	return err
+	args := struct {
+		Password string `json:"password"`
+	}{Password: password}
+	return execute(socket, "change-vnc-password", args, nil)
+}
+
+// execHMP runs a human monitor command for which there is no QMP equivalent.
+// These report errors as text in the return value rather than as a QMP error.
+func execHMP(socket, cmd string) error {
+	args := struct {
+		CommandLine string `json:"command-line"`
+	}{CommandLine: cmd}
+	var out string
+	if err := execute(socket, "human-monitor-command", args, &out); err != nil {
+		return err
+	}
+	if out = strings.TrimSpace(out); out != "" {
+		return fmt.Errorf("%s: %s", cmd, out)
+	}
+	return nil
+}
+
+func execSaveVM(socket, name string) error {
+	return execHMP(socket, "savevm "+name)
+}
+
+func execLoadVM(socket, name string) error {
+	return execHMP(socket, "loadvm "+name)
+}
+
+func execBalloon(socket string, bytes int64) error {
+	args := struct {
+		Value int64 `json:"value"`
+	}{Value: bytes}
+	return execute(socket, "balloon", args, nil)
+}
+
+// execBlockdevAdd opens a disk image as a block node for device_add
+func execBlockdevAdd(socket, nodeName, fileName, format string, readOnly bool) error {
+	args := map[string]interface{}{
+		"driver":    format,
+		"node-name": nodeName,
+		"read-only": readOnly,
+		"file": map[string]interface{}{
+			"driver":   "file",
+			"filename": fileName,
+			"aio":      "io_uring",
+		},
+	}
+	return execute(socket, "blockdev-add", args, nil)
+}
+
+func execBlockdevDel(socket, nodeName string) error {
+	args := struct {
+		NodeName string `json:"node-name"`
+	}{NodeName: nodeName}
+	return execute(socket, "blockdev-del", args, nil)
+}
+
+// execDeviceAdd takes the driver, id and properties of the device in args
+func execDeviceAdd(socket string, args map[string]interface{}) error {
+	return execute(socket, "device_add", args, nil)
+}
+
+// execDeviceDel only asks the guest to release the device; it is gone
+// once the guest has done so
+func execDeviceDel(socket, id string) error {
+	args := struct {
+		ID string `json:"id"`
+	}{ID: id}
+	return execute(socket, "device_del", args, nil)
+}
+
+func execNetdevAdd(socket string, args map[string]interface{}) error {
+	return execute(socket, "netdev_add", args, nil)
+}
+
+func execNetdevDel(socket, id string) error {
+	args := struct {
+		ID string `json:"id"`
+	}{ID: id}
+	return execute(socket, "netdev_del", args, nil)
+}
+
+// getBalloonActual returns how many bytes the guest currently has
+func getBalloonActual(socket string) (int64, error) {
+	var result struct {
+		Actual int64 `json:"actual"`
+	}
+	err := execute(socket, "query-balloon", nil, &result)
+	return result.Actual, err
+}
+
+// qmpStatusInfo is what query-status returns
+type qmpStatusInfo struct {
+	Running    bool   `json:"running"`
+	Singlestep bool   `json:"singlestep"`
+	Status     string `json:"status"`
 }
 
 func getQemuStatus(socket string) (string, error) {
-	if raw, err := execRawCmd(socket, `{ "execute": "query-status" }`); err == nil {
-		var result struct {
-			ID     string `json:"id"`
-			Return struct {
-				Running    bool   `json:"running"`
-				Singlestep bool   `json:"singlestep"`
-				Status     string `json:"status"`
-			} `json:"return"`
+	var result qmpStatusInfo
+	err := execute(socket, "query-status", nil, &result)
+	return result.Status, err
+}
+
+// qmpEventTypes are the QMP events we pass on as DomainEvents
+var qmpEventTypes = map[string]types.DomainEventType{
+	"SHUTDOWN":       types.DomainEventShutdown,
+	"RESET":          types.DomainEventReset,
+	"BLOCK_IO_ERROR": types.DomainEventBlockIOError,
+	"GUEST_PANICKED": types.DomainEventGuestPanicked,
+	"WATCHDOG":       types.DomainEventWatchdog,
+	"RESUME":         types.DomainEventResume,
+}
+
+// qmpToDomainEvent translates a QMP event, returns false for events we don't pass on
+func qmpToDomainEvent(domainName string, event qmp.Event) (types.DomainEvent, bool) {
+	eventType, found := qmpEventTypes[event.Event]
+	if !found {
+		return types.DomainEvent{}, false
+	}
+	details := ""
+	if len(event.Data) != 0 {
+		if data, err := json.Marshal(event.Data); err == nil {
+			details = string(data)
 		}
-		err = json.Unmarshal(raw, &result)
-		return result.Return.Status, err
-	} else {
-		return "", err
 	}
+	return types.DomainEvent{
+		DomainName: domainName,
+		Type:       eventType,
+		Time:       time.Unix(event.Timestamp.Seconds, event.Timestamp.Microseconds*int64(time.Microsecond)),
+		Details:    details,
+	}, true
 }
 
-func qmpEventHandler(listenerSocket, executorSocket string) {
+// qmpEventHandler listens for the events of a domain until qemu goes away. It
+// tears down the domain on SHUTDOWN (since qemu runs with -no-shutdown) and
+// passes on the events domainmgr cares about without ever blocking on events.
+func qmpEventHandler(listenerSocket, executorSocket, domainName string, events chan<- types.DomainEvent) {
 	monitor, err := qmp.NewSocketMonitor("unix", listenerSocket, sockTimeout)
 	if err != nil {
 		logrus.Errorf("qmpEventHandler: Exception while getting monitor of listenerSocket: %s. %s", listenerSocket, err.Error())
@@ -100,26 +238,29 @@ func qmpEventHandler(listenerSocket, executorSocket string) {
 		logrus.Errorf("qmpEventHandler: Exception while getting event channel from listenerSocket: %s. %s", listenerSocket, err.Error())
 		return
 	}
-	for {
-		if _, err := os.Stat(listenerSocket); err != nil {
-			logrus.Errorf("qmpEventHandler: Exception while accessing listenerSocket: %s. %s", listenerSocket, err.Error())
-			return
+	for event := range eventChan {
+		switch event.Event {
+		case "SHUTDOWN":
+			logrus.Infof("qmpEventHandler: Received event: %s event details: %v. Calling quit on socket: %s", event.Event, event.Data, executorSocket)
+			if err := execStop(executorSocket); err != nil {
+				logrus.Errorf("qmpEventHandler: Exception while stopping domain with socket: %s. %s", executorSocket, err.Error())
+			}
+			if err := execQuit(executorSocket); err != nil {
+				logrus.Errorf("qmpEventHandler: Exception while quitting domain with socket: %s. %s", executorSocket, err.Error())
+			}
+		case "RESET", "BLOCK_IO_ERROR", "GUEST_PANICKED", "WATCHDOG":
+			logrus.Warnf("qmpEventHandler: Received event: %s event details: %v from listenerSocket: %s", event.Event, event.Data, listenerSocket)
+		default:
+			//Not handling the following events: NIC_RX_FILTER_CHANGED, RTC_CHANGE, POWERDOWN, STOP
+			logrus.Debugf("qmpEventHandler: Unhandled event: %s from listenerSocket: %s", event.Event, listenerSocket)
 		}
-		select {
-		case event := <-eventChan:
-			switch event.Event {
-			case "SHUTDOWN":
-				logrus.Infof("qmpEventHandler: Received event: %s event details: %v. Calling quit on socket: %s", event.Event, event.Data, executorSocket)
-				if err := execStop(executorSocket); err != nil {
-					logrus.Errorf("qmpEventHandler: Exception while stopping domain with socket: %s. %s", executorSocket, err.Error())
-				}
-				if err := execQuit(executorSocket); err != nil {
-					logrus.Errorf("qmpEventHandler: Exception while quitting domain with socket: %s. %s", executorSocket, err.Error())
-				}
+		if domainEvent, ok := qmpToDomainEvent(domainName, event); ok && events != nil {
+			select {
+			case events <- domainEvent:
 			default:
-				//Not handling the following events: RESUME, NIC_RX_FILTER_CHANGED, RTC_CHANGE, POWERDOWN, STOP
-				logrus.Debugf("qmpEventHandler: Unhandled event: %s from listenerSocket: %s", event.Event, listenerSocket)
+				logrus.Warnf("qmpEventHandler: dropped event %s for %s: nobody is reading events", event.Event, domainName)
 			}
 		}
 	}
+	logrus.Infof("qmpEventHandler: done with listenerSocket: %s", listenerSocket)
 }
@@ -0,0 +1,152 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package hypervisor
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+// fakeQmp serves a QMP socket: it answers query-status with the given
+// status, fails any other command and sends whatever comes in on events
+// to the last connection. Each connection accepted is counted in conns.
+func fakeQmp(t *testing.T, socket string, status string, events <-chan string, conns chan<- int) net.Listener {
+	l, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Fatalf("can't listen on %s: %v", socket, err)
+	}
+	go func() {
+		for n := 1; ; n++ {
+			c, err := l.Accept()
+			if err != nil {
+				return
+			}
+			conns <- n
+			go func(c net.Conn) {
+				defer c.Close()
+				var mu sync.Mutex
+				reply := func(format string, a ...interface{}) {
+					mu.Lock()
+					defer mu.Unlock()
+					fmt.Fprintf(c, format+"\n", a...)
+				}
+				go func() {
+					for e := range events {
+						reply("%s", e)
+					}
+				}()
+				reply(`{"QMP": {"version": {"qemu": {"micro": 0, "minor": 1, "major": 5}, "package": ""}, "capabilities": []}}`)
+				// like qemu, don't expect commands to be separated by newlines
+				dec := json.NewDecoder(c)
+				for {
+					var cmd struct {
+						Execute string `json:"execute"`
+					}
+					if err := dec.Decode(&cmd); err != nil {
+						return
+					}
+					switch cmd.Execute {
+					case "qmp_capabilities":
+						reply(`{"return": {}}`)
+					case "query-status":
+						reply(`{"return": {"status": "%s", "singlestep": false, "running": true}}`, status)
+					default:
+						reply(`{"error": {"class": "CommandNotFound", "desc": "The command %s has not been found"}}`, cmd.Execute)
+					}
+				}
+			}(c)
+		}
+	}()
+	return l
+}
+
+func TestQmpCommands(t *testing.T) {
+	dir, err := ioutil.TempDir("", "qmp")
+	if err != nil {
+		t.Fatalf("can't create temp dir %v", err)
+	}
+	defer os.RemoveAll(dir)
+	socket := filepath.Join(dir, "qmp")
+
+	conns := make(chan int, 10)
+	l := fakeQmp(t, socket, "running", nil, conns)
+	defer l.Close()
+
+	for i := 0; i < 3; i++ {
+		if status, err := getQemuStatus(socket); err != nil || status != "running" {
+			t.Errorf("getQemuStatus returned %s, %v", status, err)
+		}
+	}
+	// the monitor only serves one client hence each command has to
+	// connect and let go of the connection when done
+	if len(conns) != 3 {
+		t.Errorf("expected a connection per command, got %d", len(conns))
+	}
+
+	if err := execContinue(socket); err == nil {
+		t.Errorf("execContinue should've failed since fake qemu doesn't know cont")
+	}
+	if _, err := getQemuStatus(socket); err != nil {
+		t.Errorf("getQemuStatus failed after a command error %v", err)
+	}
+
+	// qemu restarted
+	l.Close()
+	os.Remove(socket)
+	l = fakeQmp(t, socket, "paused", nil, conns)
+	defer l.Close()
+	if status, err := getQemuStatus(socket); err != nil || status != "paused" {
+		t.Errorf("getQemuStatus after restart returned %s, %v", status, err)
+	}
+}
+
+func TestQmpEventHandler(t *testing.T) {
+	dir, err := ioutil.TempDir("", "qmp")
+	if err != nil {
+		t.Fatalf("can't create temp dir %v", err)
+	}
+	defer os.RemoveAll(dir)
+	socket := filepath.Join(dir, "listener")
+
+	conns := make(chan int, 10)
+	qmpEvents := make(chan string, 10)
+	defer close(qmpEvents)
+	l := fakeQmp(t, socket, "running", qmpEvents, conns)
+	defer l.Close()
+
+	events := make(chan types.DomainEvent, 10)
+	go qmpEventHandler(socket, filepath.Join(dir, "executor"), "test.1", events)
+
+	// events sent before the handler subscribes are dropped by the monitor
+	<-conns
+	time.Sleep(time.Second)
+	qmpEvents <- `{"timestamp": {"seconds": 1600000000, "microseconds": 5}, "event": "RTC_CHANGE", "data": {"offset": 1}}`
+	qmpEvents <- `{"timestamp": {"seconds": 1600000001, "microseconds": 0}, "event": "BLOCK_IO_ERROR", "data": {"device": "drive-virtio-disk0", "operation": "write", "action": "stop"}}`
+	qmpEvents <- `{"timestamp": {"seconds": 1600000002, "microseconds": 0}, "event": "RESUME"}`
+	qmpEvents <- `{"timestamp": {"seconds": 1600000003, "microseconds": 0}, "event": "GUEST_PANICKED", "data": {"action": "pause"}}`
+
+	expected := []types.DomainEventType{types.DomainEventBlockIOError, types.DomainEventResume, types.DomainEventGuestPanicked}
+	for _, eventType := range expected {
+		select {
+		case event := <-events:
+			if event.Type != eventType || event.DomainName != "test.1" {
+				t.Errorf("expected %s event for test.1, got %s for %s", eventType, event.Type, event.DomainName)
+			}
+			if event.Time.IsZero() || (event.Details == "" && event.Type != types.DomainEventResume) {
+				t.Errorf("event %s is missing time or details: %v", event.Type, event)
+			}
+		case <-time.After(10 * time.Second):
+			t.Fatalf("timed out waiting for %s event", eventType)
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/shirou/gopsutil/cpu"
 	"github.com/shirou/gopsutil/mem"
 	"github.com/sirupsen/logrus"
+	"io"
 	"io/ioutil"
 	"os"
 	"runtime"
@@ -21,6 +22,9 @@ const (
 	dom0Name = "Domain-0"
 )
 
+// xenTasksDir is where xen-start keeps the state of each domain
+var xenTasksDir = "/run/tasks/"
+
 type typeAndPCI struct {
 	pciLong string
 	ioType  types.IoType
@@ -129,6 +133,9 @@ func (ctx xenContext) Task(status *types.DomainStatus) types.Task {
 }
 
 func (ctx xenContext) Setup(status types.DomainStatus, config types.DomainConfig, aa *types.AssignableAdapters, file *os.File) error {
+	if status.IsPod() {
+		return logError("failed to run domain %s: pods only run as containers", status.DomainName)
+	}
 	// first lets build the domain config
 	if err := ctx.CreateDomConfig(status.DomainName, config, status.DiskStatusList, aa, file); err != nil {
 		return logError("failed to build domain config: %v", err)
@@ -508,11 +515,66 @@ func (ctx xenContext) Delete(domainName string, domainID int) (result error) {
 	return nil
 }
 
+func (ctx xenContext) SecurityProfile(domainName string) (*types.EffectiveSecurityProfile, error) {
+	// the task of a VM is the device model
+	return nil, nil
+}
+
+// Console runs xl console in xen-tools; xen-start leaves the console to us
+func (ctx xenContext) Console(domainName string, domainID int) (io.ReadWriteCloser, bool, error) {
+	ctrdSystemCtx, done := ctx.ctrdClient.CtrNewSystemServicesCtx()
+	term, err := ctx.ctrdClient.CtrSystemExecTerminal(ctrdSystemCtx, "xen-tools",
+		[]string{"xl", "console", domainName})
+	if err != nil {
+		done()
+		return nil, false, logError("can't attach to the console of %s: %v", domainName, err)
+	}
+	return &xenConsole{ReadWriteCloser: term, done: done}, false, nil
+}
+
+// xenConsole keeps the context of the xl console process until it is closed
+type xenConsole struct {
+	io.ReadWriteCloser
+	done func()
+}
+
+func (c *xenConsole) Close() error {
+	err := c.ReadWriteCloser.Close()
+	c.done()
+	return err
+}
+
+func (ctx xenContext) PodContainers(domainName string) ([]types.PodContainerStatus, error) {
+	return nil, nil
+}
+
+func (ctx xenContext) SetMemory(domainName string, domainID int, memory int) error {
+	logrus.Infof("xlMemSet %s %d %dk\n", domainName, domainID, memory)
+	ctrdSystemCtx, done := ctx.ctrdClient.CtrNewSystemServicesCtx()
+	defer done()
+	stdOut, stdErr, err := ctx.ctrdClient.CtrSystemExec(ctrdSystemCtx, "xen-tools",
+		[]string{"xl", "mem-set", domainName, fmt.Sprintf("%dk", memory)})
+	if err != nil {
+		logrus.Errorln("xl mem-set failed ", err)
+		logrus.Errorln("xl mem-set output ", stdOut, stdErr)
+		return fmt.Errorf("xl mem-set failed: %s %s", stdOut, stdErr)
+	}
+	return nil
+}
+
+func (ctx xenContext) Snapshot(domainName string, domainID int, snapshotName string) error {
+	return logError("snapshot of xen domain %s is not supported", domainName)
+}
+
+func (ctx xenContext) Restore(domainName string, domainID int, snapshotName string) error {
+	return logError("restore of xen domain %s is not supported", domainName)
+}
+
 func (ctx xenContext) Info(domainName string, domainID int) (int, types.SwState, error) {
 	// first we ask for the task status
 	effectiveDomainID, effectiveDomainState, err := ctx.ctrdContext.Info(domainName, domainID)
 	if err != nil || effectiveDomainState != types.RUNNING {
-		status, err := ioutil.ReadFile("/run/tasks/" + domainName)
+		status, err := ioutil.ReadFile(xenTasksDir + domainName)
 		if err != nil {
 			status = []byte("file not read")
 		}
@@ -523,7 +585,7 @@ func (ctx xenContext) Info(domainName string, domainID int) (int, types.SwState,
 	}
 
 	// if task is alive, we augment task status with finer grained details from xl info
-	status, err := ioutil.ReadFile("/run/tasks/" + domainName)
+	status, err := ioutil.ReadFile(xenTasksDir + domainName)
 	if err != nil {
 		logrus.Errorf("couldn't read task status file: %v", err)
 		status = []byte("running") // assigning default state as we weren't able to read status file
@@ -772,6 +834,7 @@ func parseCPUMemoryStat(cpuMemoryStat [][]string, dmList map[string]types.Domain
 			UsedMemory:        uint32(usedMemory),
 			AvailableMemory:   uint32(availableMemory),
 			UsedMemoryPercent: float64(usedMemoryPercent),
+			AllocatedMB:       uint32(totalMemory),
 		}
 		result[domainname] = dm
 	}
@@ -810,3 +873,52 @@ func fallbackDomainMetric() map[string]types.DomainMetric {
 	dmList[dom0Name] = dm
 	return dmList
 }
+
+func (ctx xenContext) xlExec(args ...string) error {
+	ctrdSystemCtx, done := ctx.ctrdClient.CtrNewSystemServicesCtx()
+	defer done()
+	stdOut, stdErr, err := ctx.ctrdClient.CtrSystemExec(ctrdSystemCtx, "xen-tools",
+		append([]string{"xl"}, args...))
+	if err != nil {
+		logrus.Errorf("xl %s failed %v", args[0], err)
+		logrus.Errorf("xl %s output %s %s", args[0], stdOut, stdErr)
+		return fmt.Errorf("xl %s failed: %s %s", args[0], stdOut, stdErr)
+	}
+	logrus.Infof("xl %s done: stdout: %s, stderr: %s", args[0], stdOut, stdErr)
+	return nil
+}
+
+func (ctx xenContext) AttachDisk(domainName string, domainID int, disk types.DiskStatus) error {
+	access := "rw"
+	if disk.ReadOnly {
+		access = "ro"
+	}
+	// same disk spec as in CreateDomConfig
+	return ctx.xlExec("block-attach", domainName, fmt.Sprintf("%s,%s,%s,%s",
+		disk.FileLocation, strings.ToLower(disk.Format.String()), disk.Vdev, access))
+}
+
+func (ctx xenContext) DetachDisk(domainName string, domainID int, disk types.DiskStatus) error {
+	return ctx.xlExec("block-detach", domainName, disk.Vdev)
+}
+
+// AttachUsb is not supported since xl usbdev-detach wants the port of the
+// controller of the domain rather than the device; USB devices assigned
+// by rule get picked up the next time the domain boots
+func (ctx xenContext) AttachUsb(domainName string, domainID int, usbAddr string) error {
+	return logError("can't hot-plug USB device %s into xen domain %s", usbAddr, domainName)
+}
+
+func (ctx xenContext) DetachUsb(domainName string, domainID int, usbAddr string) error {
+	return logError("can't unplug USB device %s from xen domain %s", usbAddr, domainName)
+}
+
+func (ctx xenContext) AttachVif(domainName string, domainID int, vif types.VifInfo) error {
+	// emulated (ioemu) NICs can't be hot-plugged hence always a PV vif
+	return ctx.xlExec("network-attach", domainName, fmt.Sprintf("bridge=%s", vif.Bridge),
+		fmt.Sprintf("vifname=%s", vif.Vif), fmt.Sprintf("mac=%s", vif.Mac), "type=vif")
+}
+
+func (ctx xenContext) DetachVif(domainName string, domainID int, vif types.VifInfo) error {
+	return ctx.xlExec("network-detach", domainName, vif.Mac)
+}
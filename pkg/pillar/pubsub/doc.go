@@ -25,7 +25,9 @@
 //
 // Included is the `SocketDriver`, which uses a Unix-domain socket to
 // communicate between publishers and subscribers, and local directories to
-// store persistent messages.
+// store persistent messages, and the `MemoryDriver` in the memdriver
+// package, which connects publications and subscriptions within a single
+// process without sockets or files, for use in tests.
 //
 //
 // see the documentation for each element to understand its usage.
@@ -46,6 +48,14 @@
 //   }
 //
 //
+// Schema versions
+//
+// When the meaning of a field in a persistent topic changes, register a
+// `SchemaMigration` for the topic using `RegisterSchemaMigration`. Items
+// of such topics are stamped with the current schema version when they are
+// persisted, and items from older versions are migrated when they are
+// loaded by a publication or subscription.
+//
 // Driver
 //
 // The driver is responsible for implementing the underlying mechanics of
@@ -0,0 +1,12 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package pubsub
+
+// ResetSchemaMigrations drops the migrations registered for the topic of
+// topicType so that a test can register them again when it is rerun
+func ResetSchemaMigrations(topicType interface{}) {
+	schemaRegistry.Lock()
+	defer schemaRegistry.Unlock()
+	delete(schemaRegistry.migrations, TypeToName(topicType))
+}
@@ -0,0 +1,362 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package pubsub
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+)
+
+// The introspection registry tracks all publications and subscriptions
+// across all PubSub instances in the process, since zedbox runs each agent
+// with its own PubSub.
+var introspectRegistry = struct {
+	sync.Mutex
+	pubs map[*PublicationImpl]struct{}
+	subs map[*SubscriptionImpl]struct{}
+}{
+	pubs: make(map[*PublicationImpl]struct{}),
+	subs: make(map[*SubscriptionImpl]struct{}),
+}
+
+// topicStats is embedded in PublicationImpl and SubscriptionImpl to track
+// when the content changed and, for subscriptions, the handler latency.
+type topicStats struct {
+	statsLock       sync.Mutex
+	lastUpdate      time.Time
+	changeCount     uint64
+	handlerTotal    time.Duration
+	handlerMax      time.Duration
+	handlerLast     time.Duration
+	handlerWarnings uint64
+	handlerErrors   uint64
+}
+
+func (stats *topicStats) recordUpdate() {
+	stats.statsLock.Lock()
+	stats.lastUpdate = time.Now()
+	stats.changeCount++
+	stats.statsLock.Unlock()
+}
+
+func (stats *topicStats) recordHandler(elapsed time.Duration,
+	warnTime time.Duration, errTime time.Duration) {
+
+	stats.statsLock.Lock()
+	stats.lastUpdate = time.Now()
+	stats.changeCount++
+	stats.handlerTotal += elapsed
+	stats.handlerLast = elapsed
+	if elapsed > stats.handlerMax {
+		stats.handlerMax = elapsed
+	}
+	if elapsed > errTime && errTime != 0 {
+		stats.handlerErrors++
+	} else if elapsed > warnTime && warnTime != 0 {
+		stats.handlerWarnings++
+	}
+	stats.statsLock.Unlock()
+}
+
+func registerPublication(pub *PublicationImpl) {
+	introspectRegistry.Lock()
+	introspectRegistry.pubs[pub] = struct{}{}
+	introspectRegistry.Unlock()
+}
+
+func unregisterPublication(pub *PublicationImpl) {
+	introspectRegistry.Lock()
+	delete(introspectRegistry.pubs, pub)
+	introspectRegistry.Unlock()
+}
+
+func registerSubscription(sub *SubscriptionImpl) {
+	introspectRegistry.Lock()
+	introspectRegistry.subs[sub] = struct{}{}
+	introspectRegistry.Unlock()
+}
+
+func unregisterSubscription(sub *SubscriptionImpl) {
+	introspectRegistry.Lock()
+	delete(introspectRegistry.subs, sub)
+	introspectRegistry.Unlock()
+}
+
+// PublicationInfo describes a publication for introspection
+type PublicationInfo struct {
+	AgentName   string
+	AgentScope  string
+	Topic       string
+	Persistent  bool
+	Items       int
+	Size        int // Sum of the json encoding of the items
+	Subscribers int // Currently connected subscribers
+	Changes     uint64
+	LastUpdate  time.Time
+}
+
+// SubscriptionInfo describes a subscription for introspection
+type SubscriptionInfo struct {
+	MyAgentName     string // The subscriber
+	AgentName       string // The publisher
+	AgentScope      string
+	Topic           string
+	Persistent      bool
+	Synchronized    bool
+	RestartCounter  int
+	Items           int
+	Size            int // Sum of the json encoding of the items
+	Changes         uint64
+	LastUpdate      time.Time
+	HandlerAverage  time.Duration
+	HandlerMax      time.Duration
+	HandlerLast     time.Duration
+	HandlerWarnings uint64      // Number of times WarningTime was exceeded
+	HandlerErrors   uint64      // Number of times ErrorTime was exceeded
+	Queue           *QueueStats `json:",omitempty"`
+}
+
+// Introspection is a snapshot of all publications and subscriptions
+type Introspection struct {
+	Publications  []PublicationInfo
+	Subscriptions []SubscriptionInfo
+}
+
+// itemsSize returns the number of items and the sum of their json size
+func itemsSize(km keyMap) (int, int) {
+	count := 0
+	size := 0
+	km.key.Range(func(key string, val interface{}) bool {
+		count++
+		b, err := json.Marshal(val)
+		if err == nil {
+			size += len(b)
+		}
+		return true
+	})
+	return count, size
+}
+
+func (pub *PublicationImpl) info() PublicationInfo {
+	count, size := itemsSize(pub.km)
+	name := pub.nameString()
+	subscribers := 0
+	pub.updaterList.lock.Lock()
+	for _, nn := range pub.updaterList.servers {
+		if nn.name == name {
+			subscribers++
+		}
+	}
+	pub.updaterList.lock.Unlock()
+	pub.statsLock.Lock()
+	defer pub.statsLock.Unlock()
+	return PublicationInfo{
+		AgentName:   pub.agentName,
+		AgentScope:  pub.agentScope,
+		Topic:       pub.topic,
+		Persistent:  pub.persistent,
+		Items:       count,
+		Size:        size,
+		Subscribers: subscribers,
+		Changes:     pub.changeCount,
+		LastUpdate:  pub.lastUpdate,
+	}
+}
+
+// The agent updates synchronized and restartCounter with the statsLock
+// held; its own reads need no lock since it is the only writer
+func (sub *SubscriptionImpl) info() SubscriptionInfo {
+	count, size := itemsSize(sub.km)
+	sub.statsLock.Lock()
+	defer sub.statsLock.Unlock()
+	info := SubscriptionInfo{
+		MyAgentName:     sub.myAgentName,
+		AgentName:       sub.agentName,
+		AgentScope:      sub.agentScope,
+		Topic:           sub.topic,
+		Persistent:      sub.Persistent,
+		Synchronized:    sub.synchronized,
+		RestartCounter:  sub.km.restartCounter,
+		Items:           count,
+		Size:            size,
+		Changes:         sub.changeCount,
+		LastUpdate:      sub.lastUpdate,
+		HandlerMax:      sub.handlerMax,
+		HandlerLast:     sub.handlerLast,
+		HandlerWarnings: sub.handlerWarnings,
+		HandlerErrors:   sub.handlerErrors,
+	}
+	if stats, ok := sub.QueueStats(); ok {
+		info.Queue = &stats
+	}
+	if sub.changeCount != 0 {
+		info.HandlerAverage = sub.handlerTotal / time.Duration(sub.changeCount)
+	}
+	return info
+}
+
+// Introspect returns a snapshot of all the publications and subscriptions
+// in the process, sorted by agent and topic.
+func Introspect() Introspection {
+	var result Introspection
+	introspectRegistry.Lock()
+	var pubs []*PublicationImpl
+	for pub := range introspectRegistry.pubs {
+		pubs = append(pubs, pub)
+	}
+	var subs []*SubscriptionImpl
+	for sub := range introspectRegistry.subs {
+		subs = append(subs, sub)
+	}
+	introspectRegistry.Unlock()
+
+	for _, pub := range pubs {
+		result.Publications = append(result.Publications, pub.info())
+	}
+	for _, sub := range subs {
+		result.Subscriptions = append(result.Subscriptions, sub.info())
+	}
+	sort.Slice(result.Publications, func(i, j int) bool {
+		pi, pj := result.Publications[i], result.Publications[j]
+		return pi.AgentName+"/"+pi.AgentScope+"/"+pi.Topic <
+			pj.AgentName+"/"+pj.AgentScope+"/"+pj.Topic
+	})
+	sort.Slice(result.Subscriptions, func(i, j int) bool {
+		si, sj := result.Subscriptions[i], result.Subscriptions[j]
+		return si.MyAgentName+"/"+si.AgentName+"/"+si.AgentScope+"/"+si.Topic <
+			sj.MyAgentName+"/"+sj.AgentName+"/"+sj.AgentScope+"/"+sj.Topic
+	})
+	return result
+}
+
+// TopicEdge is an edge in the topic graph from the publishing agent to the
+// subscribing agent
+type TopicEdge struct {
+	From       string
+	To         string
+	AgentScope string `json:",omitempty"`
+	Topic      string
+}
+
+// TopicGraph is the graph of agents and the topics between them
+type TopicGraph struct {
+	Agents []string
+	Edges  []TopicEdge
+}
+
+// Graph returns the agent/topic graph. The publisher of a global topic
+// is shown as "global" and an unnamed subscriber as "unknown".
+func (in Introspection) Graph() TopicGraph {
+	var graph TopicGraph
+	agents := make(map[string]struct{})
+	addAgent := func(name string) {
+		if _, ok := agents[name]; !ok {
+			agents[name] = struct{}{}
+			graph.Agents = append(graph.Agents, name)
+		}
+	}
+	for _, pub := range in.Publications {
+		from := pub.AgentName
+		if from == "" {
+			from = Global
+		}
+		addAgent(from)
+	}
+	for _, sub := range in.Subscriptions {
+		from := sub.AgentName
+		if from == "" {
+			from = Global
+		}
+		to := sub.MyAgentName
+		if to == "" {
+			to = "unknown"
+		}
+		addAgent(from)
+		addAgent(to)
+		graph.Edges = append(graph.Edges, TopicEdge{
+			From:       from,
+			To:         to,
+			AgentScope: sub.AgentScope,
+			Topic:      sub.Topic,
+		})
+	}
+	sort.Strings(graph.Agents)
+	return graph
+}
+
+// DOT returns the graph in the graphviz dot format
+func (graph TopicGraph) DOT() string {
+	var buf bytes.Buffer
+	buf.WriteString("digraph pubsub {\n")
+	for _, agent := range graph.Agents {
+		fmt.Fprintf(&buf, "\t%q;\n", agent)
+	}
+	for _, edge := range graph.Edges {
+		label := edge.Topic
+		if edge.AgentScope != "" {
+			label = edge.AgentScope + "/" + edge.Topic
+		}
+		fmt.Fprintf(&buf, "\t%q -> %q [label=%q];\n",
+			edge.From, edge.To, label)
+	}
+	buf.WriteString("}\n")
+	return buf.String()
+}
+
+// IntrospectionHandler returns an http.Handler which serves the
+// Introspection as json on /topics, and the TopicGraph as json on /graph
+// and in dot format on /graph.dot
+func IntrospectionHandler(log *base.LogObject) http.Handler {
+	mux := http.NewServeMux()
+	writeJSON := func(w http.ResponseWriter, val interface{}) {
+		b, err := json.MarshalIndent(val, "", "  ")
+		if err != nil {
+			log.Errorf("introspection json Marshal failed: %v", err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	}
+	mux.HandleFunc("/topics", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, Introspect())
+	})
+	mux.HandleFunc("/graph", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, Introspect().Graph())
+	})
+	mux.HandleFunc("/graph.dot", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/vnd.graphviz")
+		w.Write([]byte(Introspect().Graph().DOT()))
+	})
+	return mux
+}
+
+// StartIntrospection serves IntrospectionHandler on a unix-domain socket.
+// Returns after the socket is listening; the server runs in a goroutine.
+func StartIntrospection(log *base.LogObject, sockName string) error {
+	if err := os.MkdirAll(path.Dir(sockName), 0700); err != nil {
+		return fmt.Errorf("StartIntrospection(%s): %v", sockName, err)
+	}
+	// Remove any left-over from a previous run
+	os.Remove(sockName)
+	listener, err := net.Listen("unix", sockName)
+	if err != nil {
+		return fmt.Errorf("StartIntrospection(%s): %v", sockName, err)
+	}
+	go func() {
+		err := http.Serve(listener, IntrospectionHandler(log))
+		log.Errorf("StartIntrospection(%s) exiting: %v", sockName, err)
+	}()
+	return nil
+}
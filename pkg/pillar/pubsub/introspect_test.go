@@ -0,0 +1,111 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package pubsub_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub/memdriver"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+type introspectItem struct {
+	FieldA string
+}
+
+func TestIntrospection(t *testing.T) {
+	rootPath, err := ioutil.TempDir("", "introspect_test")
+	if err != nil {
+		t.Fatalf("TempDir failed: %s", err)
+	}
+	defer os.RemoveAll(rootPath)
+
+	logger := logrus.StandardLogger()
+	logger.SetLevel(logrus.ErrorLevel)
+	log := base.NewSourceLogObject(logger, "test", 1234)
+	driver := memdriver.MemoryDriver{
+		Logger:  logger,
+		Log:     log,
+		RootDir: rootPath,
+	}
+	ps := pubsub.New(&driver, logger, log)
+
+	pub, err := ps.NewPublication(pubsub.PublicationOptions{
+		AgentName: "publisher",
+		TopicType: introspectItem{},
+	})
+	if err != nil {
+		t.Fatalf("unable to publish: %v", err)
+	}
+	pub.Publish("key1", introspectItem{FieldA: "item1"})
+	pub.Publish("key2", introspectItem{FieldA: "item2"})
+	sub, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName:   "publisher",
+		MyAgentName: "subscriber",
+		TopicImpl:   introspectItem{},
+		Activate:    true,
+	})
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+	for !sub.Synchronized() {
+		change := <-sub.MsgChan()
+		sub.ProcessChange(change)
+	}
+
+	var pubInfo *pubsub.PublicationInfo
+	var subInfo *pubsub.SubscriptionInfo
+	in := pubsub.Introspect()
+	for i := range in.Publications {
+		if in.Publications[i].Topic == "introspectItem" {
+			pubInfo = &in.Publications[i]
+		}
+	}
+	for i := range in.Subscriptions {
+		if in.Subscriptions[i].Topic == "introspectItem" {
+			subInfo = &in.Subscriptions[i]
+		}
+	}
+	if pubInfo == nil || subInfo == nil {
+		t.Fatalf("topic missing from %+v", in)
+	}
+	assert.Equal(t, "publisher", pubInfo.AgentName)
+	assert.Equal(t, 2, pubInfo.Items)
+	assert.Equal(t, 1, pubInfo.Subscribers)
+	assert.Equal(t, uint64(2), pubInfo.Changes)
+	assert.Equal(t, len(`{"FieldA":"item1"}`)*2, pubInfo.Size)
+	assert.Equal(t, "subscriber", subInfo.MyAgentName)
+	assert.Equal(t, 2, subInfo.Items)
+	assert.True(t, subInfo.Synchronized)
+	assert.Equal(t, uint64(3), subInfo.Changes)
+
+	dot := in.Graph().DOT()
+	assert.Contains(t, dot, `"publisher" -> "subscriber" [label="introspectItem"];`)
+
+	server := httptest.NewServer(pubsub.IntrospectionHandler(log))
+	defer server.Close()
+	resp, err := server.Client().Get(server.URL + "/graph")
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	defer resp.Body.Close()
+	var graph pubsub.TopicGraph
+	assert.Nil(t, json.NewDecoder(resp.Body).Decode(&graph))
+	assert.Contains(t, graph.Edges, pubsub.TopicEdge{From: "publisher",
+		To: "subscriber", Topic: "introspectItem"})
+
+	sub.Close()
+	pub.Close()
+	in = pubsub.Introspect()
+	for _, info := range in.Publications {
+		assert.NotEqual(t, "introspectItem", info.Topic)
+	}
+}
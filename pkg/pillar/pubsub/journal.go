@@ -0,0 +1,239 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package pubsub
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+)
+
+const (
+	// maxJournalLine is the longest line we accept when reading a journal
+	maxJournalLine = 16 * 1024 * 1024
+	// journalModify etc are the compact encodings of the operation
+	journalModify  = "M"
+	journalDelete  = "D"
+	journalRestart = "R"
+)
+
+// JournalEntry is one recorded change to a publication.
+// The short json tags keep the journal file compact.
+type JournalEntry struct {
+	Time       time.Time       `json:"t"`
+	AgentName  string          `json:"a,omitempty"`
+	AgentScope string          `json:"s,omitempty"`
+	Topic      string          `json:"n"`
+	Operation  string          `json:"o"`
+	Key        string          `json:"k,omitempty"`
+	Value      json.RawMessage `json:"v,omitempty"`
+}
+
+// Change returns the pubsub.Change corresponding to the entry
+func (entry JournalEntry) Change() (Change, error) {
+	switch entry.Operation {
+	case journalModify:
+		return Change{Operation: Modify, Key: entry.Key,
+			Value: []byte(entry.Value)}, nil
+	case journalDelete:
+		return Change{Operation: Delete, Key: entry.Key}, nil
+	case journalRestart:
+		return Change{Operation: Restart, Key: entry.Key}, nil
+	default:
+		return Change{}, fmt.Errorf("unknown journal operation %s",
+			entry.Operation)
+	}
+}
+
+// nameString returns the name a subscription to the entry's publication
+// passes to the driver. A global publication has no AgentName and its
+// subscriptions pass "/<topic>", not Global like the publication does.
+func (entry JournalEntry) nameString() string {
+	if entry.AgentScope == "" {
+		return fmt.Sprintf("%s/%s", entry.AgentName, entry.Topic)
+	}
+	return fmt.Sprintf("%s/%s/%s", entry.AgentName, entry.AgentScope,
+		entry.Topic)
+}
+
+// Journal records every Publish, Unpublish and Restart of the
+// publications of a PubSub to a file, one json entry per line.
+// If maxSize is set the file is rotated to filename.1 when it exceeds
+// maxSize bytes, hence at most twice maxSize is used.
+type Journal struct {
+	lock     sync.Mutex
+	filename string
+	maxSize  int64
+	file     *os.File
+	size     int64
+	log      *base.LogObject
+}
+
+// NewJournal opens the journal file for appending
+func NewJournal(log *base.LogObject, filename string, maxSize int64) (*Journal, error) {
+	j := &Journal{
+		filename: filename,
+		maxSize:  maxSize,
+		log:      log,
+	}
+	if err := j.open(); err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (j *Journal) open() error {
+	file, err := os.OpenFile(j.filename,
+		os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return fmt.Errorf("NewJournal(%s): %v", j.filename, err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("NewJournal(%s): %v", j.filename, err)
+	}
+	j.file = file
+	j.size = info.Size()
+	return nil
+}
+
+// rotate must be called with the lock held
+func (j *Journal) rotate() error {
+	j.file.Close()
+	j.file = nil
+	if err := os.Rename(j.filename, j.filename+".1"); err != nil {
+		return err
+	}
+	return j.open()
+}
+
+// record appends an entry. Errors are logged since a failure to record
+// should not affect the publisher.
+func (j *Journal) record(entry JournalEntry) {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		j.log.Errorf("Journal(%s) Marshal failed: %v", j.filename, err)
+		return
+	}
+	b = append(b, '\n')
+	j.lock.Lock()
+	defer j.lock.Unlock()
+	if j.file == nil {
+		return
+	}
+	if j.maxSize != 0 && j.size+int64(len(b)) > j.maxSize && j.size != 0 {
+		if err := j.rotate(); err != nil {
+			j.log.Errorf("Journal(%s) rotate failed: %v", j.filename, err)
+			return
+		}
+	}
+	n, err := j.file.Write(b)
+	j.size += int64(n)
+	if err != nil {
+		j.log.Errorf("Journal(%s) Write failed: %v", j.filename, err)
+	}
+}
+
+// Close the journal file. Subsequent changes are not recorded.
+func (j *Journal) Close() error {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+	if j.file == nil {
+		return nil
+	}
+	err := j.file.Close()
+	j.file = nil
+	return err
+}
+
+// recordPublish, recordUnpublish and recordRestart are called by the
+// PublicationImpl when a journal is set
+func (j *Journal) recordPublish(pub *PublicationImpl, key string, b []byte) {
+	j.record(JournalEntry{
+		Time:       time.Now(),
+		AgentName:  pub.agentName,
+		AgentScope: pub.agentScope,
+		Topic:      pub.topic,
+		Operation:  journalModify,
+		Key:        key,
+		Value:      json.RawMessage(b),
+	})
+}
+
+func (j *Journal) recordUnpublish(pub *PublicationImpl, key string) {
+	j.record(JournalEntry{
+		Time:       time.Now(),
+		AgentName:  pub.agentName,
+		AgentScope: pub.agentScope,
+		Topic:      pub.topic,
+		Operation:  journalDelete,
+		Key:        key,
+	})
+}
+
+func (j *Journal) recordRestart(pub *PublicationImpl, restartCounter int) {
+	j.record(JournalEntry{
+		Time:       time.Now(),
+		AgentName:  pub.agentName,
+		AgentScope: pub.agentScope,
+		Topic:      pub.topic,
+		Operation:  journalRestart,
+		Key:        strconv.Itoa(restartCounter),
+	})
+}
+
+// ReadJournal reads all the entries from a journal
+func ReadJournal(r io.Reader) ([]JournalEntry, error) {
+	var entries []JournalEntry
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxJournalLine)
+	line := 0
+	for scanner.Scan() {
+		line++
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var entry JournalEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return entries, fmt.Errorf("ReadJournal line %d: %v",
+				line, err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, scanner.Err()
+}
+
+// ReplayJournal feeds the entries for agentName, agentScope and topic
+// to sub in journal order by calling ProcessChange, hence the handlers
+// of the subscription are called in the same order as on the device.
+// Since the journal only has changes, a Sync is delivered after the
+// last entry. Returns the number of entries which were replayed.
+func ReplayJournal(entries []JournalEntry, agentName string, agentScope string,
+	topic string, sub Subscription) (int, error) {
+
+	count := 0
+	for _, entry := range entries {
+		if entry.AgentName != agentName ||
+			entry.AgentScope != agentScope ||
+			entry.Topic != topic {
+			continue
+		}
+		change, err := entry.Change()
+		if err != nil {
+			return count, err
+		}
+		sub.ProcessChange(change)
+		count++
+	}
+	sub.ProcessChange(Change{Operation: Sync, Key: "done"})
+	return count, nil
+}
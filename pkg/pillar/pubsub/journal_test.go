@@ -0,0 +1,196 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package pubsub_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJournalReplay(t *testing.T) {
+	// Run in a unique directory
+	rootPath, err := ioutil.TempDir("", "journal_test")
+	if err != nil {
+		t.Fatalf("TempDir failed: %s", err)
+	}
+	defer os.RemoveAll(rootPath)
+
+	logger := logrus.StandardLogger()
+	logger.SetLevel(logrus.ErrorLevel)
+	log := base.NewSourceLogObject(logger, "test", 1234)
+	filename := filepath.Join(rootPath, "pubsub.journal")
+	journal, err := pubsub.NewJournal(log, filename, 0)
+	if err != nil {
+		t.Fatalf("NewJournal failed: %s", err)
+	}
+
+	// Record on the "device"
+	ps := pubsub.New(&pubsub.EmptyDriver{}, logger, log)
+	ps.SetJournal(journal)
+	pub, err := ps.NewPublication(pubsub.PublicationOptions{
+		AgentName: "testagent",
+		TopicType: item{},
+	})
+	if err != nil {
+		t.Fatalf("unable to publish: %v", err)
+	}
+	other, err := ps.NewPublication(pubsub.PublicationOptions{
+		AgentName: "otheragent",
+		TopicType: item{},
+	})
+	if err != nil {
+		t.Fatalf("unable to publish: %v", err)
+	}
+	pub.Publish("key1", item{FieldA: "item1"})
+	other.Publish("key1", item{FieldA: "other"})
+	pub.Publish("key2", item{FieldA: "item2"})
+	pub.SignalRestarted()
+	pub.Publish("key1", item{FieldA: "item1 modified"})
+	pub.Unpublish("key2")
+	assert.Nil(t, journal.Close())
+
+	// Replay offline
+	f, err := os.Open(filename)
+	if err != nil {
+		t.Fatalf("Open failed: %s", err)
+	}
+	defer f.Close()
+	entries, err := pubsub.ReadJournal(f)
+	if err != nil {
+		t.Fatalf("ReadJournal failed: %s", err)
+	}
+	assert.Equal(t, 6, len(entries))
+
+	var events []string
+	ps = pubsub.New(&pubsub.EmptyDriver{}, logger, log)
+	sub, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName: "testagent",
+		TopicImpl: item{},
+		CreateHandler: func(ctxArg interface{}, key string, status interface{}) {
+			events = append(events, "create "+key)
+		},
+		ModifyHandler: func(ctxArg interface{}, key string, status interface{}, oldStatus interface{}) {
+			events = append(events, "modify "+key)
+		},
+		DeleteHandler: func(ctxArg interface{}, key string, status interface{}) {
+			events = append(events, "delete "+key)
+		},
+		RestartHandler: func(ctxArg interface{}, restartCounter int) {
+			events = append(events, fmt.Sprintf("restarted %d", restartCounter))
+		},
+	})
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+	count, err := pubsub.ReplayJournal(entries, "testagent", "",
+		pubsub.TypeToName(item{}), sub)
+	assert.Nil(t, err)
+	assert.Equal(t, 5, count)
+	assert.True(t, sub.Synchronized())
+	assert.Equal(t, []string{"create key1", "create key2", "restarted 1",
+		"modify key1", "delete key2"}, events)
+	val, err := sub.Get("key1")
+	assert.Nil(t, err)
+	assert.Equal(t, item{FieldA: "item1 modified"}, val)
+}
+
+func TestJournalRotate(t *testing.T) {
+	rootPath, err := ioutil.TempDir("", "journal_test")
+	if err != nil {
+		t.Fatalf("TempDir failed: %s", err)
+	}
+	defer os.RemoveAll(rootPath)
+
+	logger := logrus.StandardLogger()
+	logger.SetLevel(logrus.ErrorLevel)
+	log := base.NewSourceLogObject(logger, "test", 1234)
+	filename := filepath.Join(rootPath, "pubsub.journal")
+	journal, err := pubsub.NewJournal(log, filename, 1024)
+	if err != nil {
+		t.Fatalf("NewJournal failed: %s", err)
+	}
+	ps := pubsub.New(&pubsub.EmptyDriver{}, logger, log)
+	ps.SetJournal(journal)
+	pub, err := ps.NewPublication(pubsub.PublicationOptions{
+		AgentName: "testagent",
+		TopicType: item{},
+	})
+	if err != nil {
+		t.Fatalf("unable to publish: %v", err)
+	}
+	for i := 0; i < 100; i++ {
+		pub.Publish("key1", item{FieldA: fmt.Sprintf("item%d", i)})
+	}
+	journal.Close()
+	for _, name := range []string{filename, filename + ".1"} {
+		info, err := os.Stat(name)
+		assert.Nil(t, err)
+		assert.LessOrEqual(t, info.Size(), int64(1024))
+	}
+}
+
+func TestJournalDriver(t *testing.T) {
+	logger := logrus.StandardLogger()
+	logger.SetLevel(logrus.ErrorLevel)
+	log := base.NewSourceLogObject(logger, "test", 1234)
+	topic := pubsub.TypeToName(item{})
+	entries := []pubsub.JournalEntry{
+		{AgentName: "testagent", Topic: topic, Operation: "M", Key: "key1",
+			Value: []byte(`{"FieldA":"item1"}`)},
+		{AgentName: "otheragent", Topic: topic, Operation: "M", Key: "key1",
+			Value: []byte(`{"FieldA":"other"}`)},
+		{AgentName: "testagent", Topic: topic, Operation: "M", Key: "key2",
+			Value: []byte(`{"FieldA":"item2"}`)},
+		{AgentName: "testagent", Topic: topic, Operation: "D", Key: "key1"},
+		{Topic: topic, Operation: "M", Key: "global",
+			Value: []byte(`{"FieldA":"global"}`)},
+	}
+	ps := pubsub.New(&pubsub.JournalDriver{Log: log, Entries: entries},
+		logger, log)
+	testMatrix := map[string]struct {
+		agentName string
+		events    []string
+	}{
+		"agent": {
+			agentName: "testagent",
+			events:    []string{"create key1", "create key2", "delete key1"},
+		},
+		"global": {
+			agentName: "",
+			events:    []string{"create global"},
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		var events []string
+		sub, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+			AgentName: test.agentName,
+			TopicImpl: item{},
+			Activate:  true,
+			CreateHandler: func(ctxArg interface{}, key string, status interface{}) {
+				events = append(events, "create "+key)
+			},
+			DeleteHandler: func(ctxArg interface{}, key string, status interface{}) {
+				events = append(events, "delete "+key)
+			},
+		})
+		if err != nil {
+			t.Fatalf("unable to subscribe: %v", err)
+		}
+		for !sub.Synchronized() {
+			sub.ProcessChange(<-sub.MsgChan())
+		}
+		assert.Equal(t, test.events, events)
+		assert.Equal(t, 1, len(sub.GetAll()))
+		sub.Close()
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package pubsub
+
+import (
+	"fmt"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+)
+
+// JournalDriver serves the subscriptions from the changes recorded in
+// journals, hence an agent running with it sees the same changes in the
+// same order as on the device. Each subscription gets the entries for its
+// agentName, agentScope and topic followed by a Sync.
+// Publications are not served to anybody; use SetJournal to record them.
+type JournalDriver struct {
+	Log     *base.LogObject
+	Entries []JournalEntry
+}
+
+// Publisher function
+func (d *JournalDriver) Publisher(global bool, name, topic string, persistent bool, updaterList *Updaters, restarted Restarted, differ Differ) (DriverPublisher, error) {
+	return &EmptyDriverPublisher{}, nil
+}
+
+// Subscriber function
+func (d *JournalDriver) Subscriber(global bool, name, topic string, persistent bool, C chan Change) (DriverSubscriber, error) {
+	var changes []Change
+	for _, entry := range d.Entries {
+		if entry.nameString() != name {
+			continue
+		}
+		change, err := entry.Change()
+		if err != nil {
+			return nil, fmt.Errorf("Subscriber(%s): %v", name, err)
+		}
+		changes = append(changes, change)
+	}
+	d.Log.Noticef("Subscriber(%s): replaying %d changes", name, len(changes))
+	return &JournalDriverSubscriber{
+		changes:  changes,
+		C:        C,
+		doneChan: make(chan struct{}),
+	}, nil
+}
+
+// DefaultName function
+func (d *JournalDriver) DefaultName() string {
+	return "journal"
+}
+
+// JournalDriverSubscriber struct
+type JournalDriverSubscriber struct {
+	changes  []Change
+	C        chan<- Change
+	doneChan chan struct{}
+}
+
+// Start sends the changes in journal order followed by a Sync
+func (s *JournalDriverSubscriber) Start() error {
+	go func() {
+		changes := append(s.changes, Change{Operation: Sync, Key: "done"})
+		for _, change := range changes {
+			select {
+			case s.C <- change:
+			case <-s.doneChan:
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// Load function
+func (s *JournalDriverSubscriber) Load() (map[string][]byte, int, error) {
+	res := make(map[string][]byte)
+	return res, 0, nil
+}
+
+// Stop function
+func (s *JournalDriverSubscriber) Stop() error {
+	select {
+	case <-s.doneChan:
+	default:
+		close(s.doneChan)
+	}
+	return nil
+}
+
+// LargeDirName where to put large fields
+func (s *JournalDriverSubscriber) LargeDirName() string {
+	return "/tmp"
+}
@@ -0,0 +1,179 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package memdriver provides an in-process implementation of pubsub.Driver.
+// All publications and subscriptions which use the same MemoryDriver are
+// connected to each other without any sockets or files, which makes it
+// possible to run several agents in a single test process.
+//
+// The semantics follow the SocketDriver: a subscriber receives the
+// current content of the publication followed by a Sync, then Restart if
+// the restartCounter is set, and subsequently Modify and Delete changes.
+// Published items are checkpointed in memory so that a new publisher for
+// the same name will Load them, and persistent items survive Reboot().
+package memdriver
+
+import (
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// fixedName is the name used for an agent when none is provided
+	fixedName = "global"
+)
+
+// MemoryDriver driver for pubsub which keeps everything in memory
+type MemoryDriver struct {
+	Logger  *logrus.Logger
+	Log     *base.LogObject
+	RootDir string // For large fields. Default is os.TempDir()
+
+	lock     sync.Mutex
+	topics   map[string]*topicState
+	instance int
+}
+
+// topicState is the checkpoint and the connected endpoints for one name
+type topicState struct {
+	persistent     bool
+	items          map[string][]byte
+	restartCounter int
+	publisher      *Publisher
+	subscribers    []*Subscriber
+}
+
+// Publisher return an implementation of `pubsub.DriverPublisher` for
+// `MemoryDriver`
+func (m *MemoryDriver) Publisher(global bool, name, topic string, persistent bool, updaterList *pubsub.Updaters, restarted pubsub.Restarted, differ pubsub.Differ) (pubsub.DriverPublisher, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	ts := m.topicStateLocked(name)
+	if ts.publisher != nil {
+		return nil, fmt.Errorf("Publish(%s): already published", name)
+	}
+	ts.persistent = persistent
+	pub := &Publisher{
+		driver:    m,
+		name:      name,
+		topic:     topic,
+		updaters:  updaterList,
+		differ:    differ,
+		restarted: restarted,
+		log:       m.Log,
+		doneChan:  make(chan struct{}),
+	}
+	ts.publisher = pub
+	return pub, nil
+}
+
+// Subscriber return an implementation of `pubsub.DriverSubscriber` for
+// `MemoryDriver`
+func (m *MemoryDriver) Subscriber(global bool, name, topic string, persistent bool, C chan pubsub.Change) (pubsub.DriverSubscriber, error) {
+	return &Subscriber{
+		driver:     m,
+		name:       name,
+		topic:      topic,
+		persistent: persistent,
+		C:          C,
+		log:        m.Log,
+		doneChan:   make(chan struct{}),
+	}, nil
+}
+
+// DefaultName default name for an agent when none is provided
+func (m *MemoryDriver) DefaultName() string {
+	return fixedName
+}
+
+// Reboot simulates a device reboot by discarding the checkpointed state of
+// all non-persistent topics. It must only be called when there are no
+// publishers or subscribers.
+func (m *MemoryDriver) Reboot() error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for name, ts := range m.topics {
+		if ts.publisher != nil || len(ts.subscribers) != 0 {
+			return fmt.Errorf("Reboot: %s still in use", name)
+		}
+		if !ts.persistent {
+			delete(m.topics, name)
+		}
+	}
+	return nil
+}
+
+// Names returns the names which have checkpointed state or endpoints
+func (m *MemoryDriver) Names() []string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	var names []string
+	for name := range m.topics {
+		names = append(names, name)
+	}
+	return names
+}
+
+func (m *MemoryDriver) largeDirName() string {
+	rootDir := m.RootDir
+	if rootDir == "" {
+		rootDir = os.TempDir()
+	}
+	return fmt.Sprintf("%s/pubsub-large", rootDir)
+}
+
+// topicStateLocked returns the state for the name, creating it if needed.
+// Caller must hold m.lock
+func (m *MemoryDriver) topicStateLocked(name string) *topicState {
+	if m.topics == nil {
+		m.topics = make(map[string]*topicState)
+	}
+	ts, ok := m.topics[name]
+	if !ok {
+		ts = &topicState{items: make(map[string][]byte)}
+		m.topics[name] = ts
+	}
+	return ts
+}
+
+// nextInstanceLocked returns a unique instance for the updaters.
+// Caller must hold m.lock
+func (m *MemoryDriver) nextInstanceLocked() int {
+	m.instance++
+	return m.instance
+}
+
+// connectLocked starts serving the subscriber from the current publisher
+// for the name, if there is one and it has been started.
+// Caller must hold m.lock
+func (m *MemoryDriver) connectLocked(ts *topicState, sub *Subscriber) {
+	pub := ts.publisher
+	if pub == nil || !pub.started {
+		return
+	}
+	instance := m.nextInstanceLocked()
+	// Insert our notification channel before the serving goroutine gets
+	// the initial snapshot to avoid missing any updates/deletes.
+	updater := make(chan pubsub.Notify, 1)
+	pub.updaters.Add(pub.log, updater, pub.name, instance)
+	go pub.serve(sub, updater, instance)
+}
+
+// loadLocked returns a copy of the checkpoint for the name.
+// Caller must hold m.lock
+func (m *MemoryDriver) loadLocked(name string) (map[string][]byte, int) {
+	items := make(map[string][]byte)
+	ts, ok := m.topics[name]
+	if !ok {
+		return items, 0
+	}
+	for key, val := range ts.items {
+		items[key] = append([]byte(nil), val...)
+	}
+	return items, ts.restartCounter
+}
@@ -0,0 +1,211 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package memdriver_test
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub/memdriver"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+type item struct {
+	FieldA string
+}
+
+// processUntil processes changes for the subscription until done returns
+// true or the timeout expires
+func processUntil(t *testing.T, sub pubsub.Subscription, done func() bool) {
+	timer := time.NewTimer(10 * time.Second)
+	defer timer.Stop()
+	for !done() {
+		select {
+		case change := <-sub.MsgChan():
+			sub.ProcessChange(change)
+		case <-timer.C:
+			t.Fatalf("timeout waiting for subscription")
+		}
+	}
+}
+
+func newPubSub(t *testing.T) (*pubsub.PubSub, *memdriver.MemoryDriver) {
+	logger := logrus.StandardLogger()
+	logger.SetLevel(logrus.ErrorLevel)
+	log := base.NewSourceLogObject(logger, "test", 1234)
+	rootPath, err := ioutil.TempDir("", "memdriver_test")
+	if err != nil {
+		t.Fatalf("TempDir failed: %s", err)
+	}
+	t.Cleanup(func() { os.RemoveAll(rootPath) })
+	driver := &memdriver.MemoryDriver{
+		Logger:  logger,
+		Log:     log,
+		RootDir: rootPath,
+	}
+	return pubsub.New(driver, logger, log), driver
+}
+
+func TestPublishSubscribe(t *testing.T) {
+	ps, _ := newPubSub(t)
+
+	var events []string
+	sub, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName: "testagent",
+		TopicImpl: item{},
+		CreateHandler: func(ctxArg interface{}, key string, status interface{}) {
+			events = append(events, "create "+key)
+		},
+		ModifyHandler: func(ctxArg interface{}, key string, status interface{}, oldStatus interface{}) {
+			events = append(events, "modify "+key)
+		},
+		DeleteHandler: func(ctxArg interface{}, key string, status interface{}) {
+			events = append(events, "delete "+key)
+		},
+		RestartHandler: func(ctxArg interface{}, restartCounter int) {
+			events = append(events, "restarted")
+		},
+		Activate: true,
+	})
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+	// Subscribe before the publisher exists
+	pub, err := ps.NewPublication(pubsub.PublicationOptions{
+		AgentName: "testagent",
+		TopicType: item{},
+	})
+	if err != nil {
+		t.Fatalf("unable to publish: %v", err)
+	}
+	pub.Publish("key1", item{FieldA: "item1"})
+	processUntil(t, sub, sub.Synchronized)
+	assert.Equal(t, []string{"create key1"}, events)
+
+	pub.Publish("key1", item{FieldA: "item1 modified"})
+	pub.Publish("key2", item{FieldA: "item2"})
+	// The two keys are sent in either order
+	processUntil(t, sub, func() bool {
+		val, _ := sub.Get("key1")
+		return len(sub.GetAll()) == 2 && val == item{FieldA: "item1 modified"}
+	})
+
+	pub.SignalRestarted()
+	processUntil(t, sub, sub.Restarted)
+
+	pub.Unpublish("key2")
+	processUntil(t, sub, func() bool { return len(sub.GetAll()) == 1 })
+	if assert.Len(t, events, 5) {
+		assert.Equal(t, "create key1", events[0])
+		assert.ElementsMatch(t, []string{"modify key1", "create key2"}, events[1:3])
+		assert.Equal(t, []string{"restarted", "delete key2"}, events[3:])
+	}
+
+	sub.Close()
+	pub.Close()
+}
+
+func TestPersistentReboot(t *testing.T) {
+	ps, driver := newPubSub(t)
+
+	for _, persistent := range []bool{false, true} {
+		pub, err := ps.NewPublication(pubsub.PublicationOptions{
+			AgentName:  "testagent",
+			AgentScope: map[bool]string{false: "run", true: "persist"}[persistent],
+			TopicType:  item{},
+			Persistent: persistent,
+		})
+		if err != nil {
+			t.Fatalf("unable to publish: %v", err)
+		}
+		pub.Publish("key1", item{FieldA: "item1"})
+		pub.SignalRestarted()
+		// Close keeps the persistent items
+		pub.Close()
+	}
+	assert.Nil(t, driver.Reboot())
+
+	for _, persistent := range []bool{false, true} {
+		pub, err := ps.NewPublication(pubsub.PublicationOptions{
+			AgentName:  "testagent",
+			AgentScope: map[bool]string{false: "run", true: "persist"}[persistent],
+			TopicType:  item{},
+			Persistent: persistent,
+		})
+		if err != nil {
+			t.Fatalf("unable to publish: %v", err)
+		}
+		items := pub.GetAll()
+		if persistent {
+			assert.Equal(t, 1, len(items))
+		} else {
+			assert.Equal(t, 0, len(items))
+		}
+
+		// A persistent subscription loads the checkpoint without
+		// waiting for the publisher
+		sub, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+			AgentName:  "testagent",
+			AgentScope: map[bool]string{false: "run", true: "persist"}[persistent],
+			TopicImpl:  item{},
+			Persistent: persistent,
+			Activate:   true,
+		})
+		if err != nil {
+			t.Fatalf("unable to subscribe: %v", err)
+		}
+		if persistent {
+			assert.Equal(t, 1, len(sub.GetAll()))
+		}
+		processUntil(t, sub, sub.Synchronized)
+		assert.Equal(t, len(items), len(sub.GetAll()))
+		sub.Close()
+		pub.Close()
+	}
+}
+
+func TestPublisherRestart(t *testing.T) {
+	ps, _ := newPubSub(t)
+
+	pub, err := ps.NewPublication(pubsub.PublicationOptions{
+		AgentName: "testagent",
+		TopicType: item{},
+	})
+	if err != nil {
+		t.Fatalf("unable to publish: %v", err)
+	}
+	pub.Publish("key1", item{FieldA: "item1"})
+	sub, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName: "testagent",
+		TopicImpl: item{},
+		Activate:  true,
+	})
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+	processUntil(t, sub, sub.Synchronized)
+
+	// A new publisher for the same name serves the existing subscriber
+	pub.Close()
+
+	pub, err = ps.NewPublication(pubsub.PublicationOptions{
+		AgentName: "testagent",
+		TopicType: item{},
+	})
+	if err != nil {
+		t.Fatalf("unable to republish: %v", err)
+	}
+	pub.Publish("key3", item{FieldA: "item3"})
+	processUntil(t, sub, func() bool {
+		_, err := sub.Get("key3")
+		return err == nil
+	})
+	sub.Close()
+	pub.Close()
+}
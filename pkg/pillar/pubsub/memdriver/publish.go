@@ -0,0 +1,200 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package memdriver
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub"
+)
+
+// Publisher implementation of `pubsub.DriverPublisher` for `MemoryDriver`.
+type Publisher struct {
+	driver    *MemoryDriver
+	name      string
+	topic     string
+	updaters  *pubsub.Updaters
+	differ    pubsub.Differ
+	restarted pubsub.Restarted
+	log       *base.LogObject
+	doneChan  chan struct{}
+	started   bool // protected by driver.lock
+}
+
+// Publish checkpoint a key-value pair. Subscribers are notified through
+// the updaters.
+func (p *Publisher) Publish(key string, item []byte) error {
+	p.driver.lock.Lock()
+	defer p.driver.lock.Unlock()
+	ts := p.driver.topicStateLocked(p.name)
+	ts.items[key] = append([]byte(nil), item...)
+	return nil
+}
+
+// Unpublish delete a key from the checkpoint
+func (p *Publisher) Unpublish(key string) error {
+	p.driver.lock.Lock()
+	defer p.driver.lock.Unlock()
+	ts := p.driver.topicStateLocked(p.name)
+	if _, ok := ts.items[key]; !ok {
+		return fmt.Errorf("Unpublish(%s/%s): key does not exist",
+			p.name, key)
+	}
+	delete(ts.items, key)
+	return nil
+}
+
+// Load load entire checkpointed data set into a map
+func (p *Publisher) Load() (map[string][]byte, int, error) {
+	p.driver.lock.Lock()
+	defer p.driver.lock.Unlock()
+	items, restartCounter := p.driver.loadLocked(p.name)
+	return items, restartCounter, nil
+}
+
+// Start start serving the current and future subscribers
+func (p *Publisher) Start() error {
+	p.driver.lock.Lock()
+	defer p.driver.lock.Unlock()
+	if p.started {
+		return nil
+	}
+	p.started = true
+	ts := p.driver.topicStateLocked(p.name)
+	for _, sub := range ts.subscribers {
+		p.driver.connectLocked(ts, sub)
+	}
+	return nil
+}
+
+// Stop the publisher. Subscribers stay registered and will be served
+// by the next publisher for the same name.
+func (p *Publisher) Stop() error {
+	p.log.Functionf("Stop(%s)", p.name)
+	p.driver.lock.Lock()
+	defer p.driver.lock.Unlock()
+	ts := p.driver.topicStateLocked(p.name)
+	if ts.publisher == p {
+		ts.publisher = nil
+	}
+	select {
+	case <-p.doneChan:
+	default:
+		close(p.doneChan)
+	}
+	return nil
+}
+
+// Restart indicate that the topic is restarted if counter is non-zero
+func (p *Publisher) Restart(restartCounter int) error {
+	p.driver.lock.Lock()
+	defer p.driver.lock.Unlock()
+	ts := p.driver.topicStateLocked(p.name)
+	ts.restartCounter = restartCounter
+	return nil
+}
+
+// CheckMaxSize always succeeds since there is no message size limit
+func (p *Publisher) CheckMaxSize(key string, val []byte) error {
+	return nil
+}
+
+// LargeDirName where to put large fields
+func (p *Publisher) LargeDirName() string {
+	return p.driver.largeDirName()
+}
+
+// serve sends the collection and subsequent changes to one subscriber
+// until either the publisher or the subscriber is stopped.
+func (p *Publisher) serve(sub *Subscriber, updater chan pubsub.Notify, instance int) {
+	p.log.Functionf("serve(%s/%d)", p.name, instance)
+	defer p.updaters.Remove(p.log, updater)
+
+	// Track the set of keys/values we are sending to the peer
+	sendToPeer := make(pubsub.LocalCollection)
+	sentRestartCounter := 0
+
+	keys := p.differ.DetermineDiffs(sendToPeer)
+	if !p.send(sub, keys, sendToPeer) {
+		return
+	}
+	if !p.sendChange(sub, pubsub.Change{Operation: pubsub.Sync, Key: "done"}) {
+		return
+	}
+	if !p.sendRestarted(sub, p.restarted.RestartCounter(), &sentRestartCounter) {
+		return
+	}
+	for {
+		select {
+		case <-p.doneChan:
+			p.log.Functionf("serve(%s/%d) publisher done", p.name, instance)
+			return
+		case <-sub.doneChan:
+			p.log.Functionf("serve(%s/%d) subscriber done", p.name, instance)
+			return
+		case <-updater:
+		}
+		// Grab any change to restartCounter before we determine diffs
+		newRestartCounter := p.restarted.RestartCounter()
+		keys := p.differ.DetermineDiffs(sendToPeer)
+		if !p.send(sub, keys, sendToPeer) {
+			return
+		}
+		if !p.sendRestarted(sub, newRestartCounter, &sentRestartCounter) {
+			return
+		}
+	}
+}
+
+// sendRestarted sends a Restart if the counter differs from what was sent.
+// Returns false if done
+func (p *Publisher) sendRestarted(sub *Subscriber, restartCounter int,
+	sentRestartCounter *int) bool {
+
+	if restartCounter == *sentRestartCounter {
+		return true
+	}
+	change := pubsub.Change{Operation: pubsub.Restart,
+		Key: strconv.Itoa(restartCounter)}
+	if !p.sendChange(sub, change) {
+		return false
+	}
+	*sentRestartCounter = restartCounter
+	return true
+}
+
+// send the updates and deletes for the keys. Returns false if done
+func (p *Publisher) send(sub *Subscriber, keys []string,
+	sendToPeer pubsub.LocalCollection) bool {
+
+	for _, key := range keys {
+		var change pubsub.Change
+		val, ok := sendToPeer[key]
+		if ok {
+			change = pubsub.Change{Operation: pubsub.Modify, Key: key,
+				Value: append([]byte(nil), val...)}
+		} else {
+			change = pubsub.Change{Operation: pubsub.Delete, Key: key}
+		}
+		if !p.sendChange(sub, change) {
+			return false
+		}
+	}
+	return true
+}
+
+// sendChange blocks until the subscriber accepts the change or one of
+// the ends is stopped. Returns false if done
+func (p *Publisher) sendChange(sub *Subscriber, change pubsub.Change) bool {
+	select {
+	case sub.C <- change:
+		return true
+	case <-p.doneChan:
+		return false
+	case <-sub.doneChan:
+		return false
+	}
+}
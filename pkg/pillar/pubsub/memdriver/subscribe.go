@@ -0,0 +1,67 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package memdriver
+
+import (
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub"
+)
+
+// Subscriber implementation of `pubsub.DriverSubscriber` for `MemoryDriver`.
+type Subscriber struct {
+	driver     *MemoryDriver
+	name       string
+	topic      string
+	persistent bool
+	C          chan<- pubsub.Change
+	log        *base.LogObject
+	doneChan   chan struct{}
+}
+
+// Load load the checkpointed data set into a map. Only persistent
+// subscriptions call this.
+func (s *Subscriber) Load() (map[string][]byte, int, error) {
+	s.driver.lock.Lock()
+	defer s.driver.lock.Unlock()
+	items, restartCounter := s.driver.loadLocked(s.name)
+	return items, restartCounter, nil
+}
+
+// Start registers the subscriber. If there is a started publisher
+// the subscriber is served right away, otherwise once the publisher
+// starts.
+func (s *Subscriber) Start() error {
+	s.driver.lock.Lock()
+	defer s.driver.lock.Unlock()
+	ts := s.driver.topicStateLocked(s.name)
+	ts.subscribers = append(ts.subscribers, s)
+	s.driver.connectLocked(ts, s)
+	return nil
+}
+
+// Stop the subscriber
+func (s *Subscriber) Stop() error {
+	s.log.Functionf("Stop(%s)", s.name)
+	s.driver.lock.Lock()
+	defer s.driver.lock.Unlock()
+	ts := s.driver.topicStateLocked(s.name)
+	subscribers := ts.subscribers[:0]
+	for _, sub := range ts.subscribers {
+		if sub != s {
+			subscribers = append(subscribers, sub)
+		}
+	}
+	ts.subscribers = subscribers
+	select {
+	case <-s.doneChan:
+	default:
+		close(s.doneChan)
+	}
+	return nil
+}
+
+// LargeDirName where to put large fields
+func (s *Subscriber) LargeDirName() string {
+	return s.driver.largeDirName()
+}
@@ -54,6 +54,8 @@ type PublicationImpl struct {
 	persistent  bool
 	logger      *logrus.Logger
 	log         *base.LogObject
+	journal     *Journal
+	topicStats
 
 	driver DriverPublisher
 }
@@ -132,6 +134,7 @@ func (pub *PublicationImpl) Publish(key string, item interface{}) error {
 		}
 	}
 	pub.km.key.Store(key, newItem)
+	pub.recordUpdate()
 
 	if pub.logger.GetLevel() == logrus.TraceLevel {
 		pub.dump("after Publish")
@@ -142,6 +145,17 @@ func (pub *PublicationImpl) Publish(key string, item interface{}) error {
 	if err != nil {
 		pub.log.Fatal("json Marshal in Publish", err)
 	}
+	if pub.journal != nil {
+		pub.journal.recordPublish(pub, key, b)
+	}
+	// Persisted items carry the schema version of the topic so that
+	// a later version can migrate them on Load
+	if pub.persistent {
+		b, err = stampSchemaVersion(pub.topic, b)
+		if err != nil {
+			return err
+		}
+	}
 
 	// We pass the full json to the driver including any pubsub-large
 	// items to have a complete checkpoint.
@@ -165,10 +179,14 @@ func (pub *PublicationImpl) Unpublish(key string) error {
 		return errors.New(errStr)
 	}
 	pub.km.key.Delete(key)
+	pub.recordUpdate()
 	if pub.logger.GetLevel() == logrus.TraceLevel {
 		pub.dump("after Unpublish")
 	}
 	pub.updatersNotify(name)
+	if pub.journal != nil {
+		pub.journal.recordUnpublish(pub, key)
+	}
 
 	return pub.driver.Unpublish(key)
 }
@@ -227,6 +245,7 @@ func (pub *PublicationImpl) Close() error {
 	}
 	pub.ClearRestarted()
 	pub.driver.Stop()
+	unregisterPublication(pub)
 	return nil
 }
 
@@ -272,6 +291,14 @@ func (pub *PublicationImpl) populate() {
 			// Handle missing files??
 			pub.log.Error(err)
 		}
+		migrated := false
+		if pub.persistent {
+			itemB, migrated, err = migrateSchema(pub.log, pub.topic, key, itemB)
+			if err != nil {
+				pub.log.Error(err)
+				continue
+			}
+		}
 		item, err := parseTemplate(pub.log, itemB, pub.topicType)
 		if err != nil {
 			// Handle bad files such as those of size zero
@@ -279,6 +306,20 @@ func (pub *PublicationImpl) populate() {
 			continue
 		}
 		pub.km.key.Store(key, item)
+		if migrated {
+			// Write back so the checkpoint has the current version
+			b, err := json.Marshal(item)
+			if err == nil {
+				b, err = stampSchemaVersion(pub.topic, b)
+			}
+			if err == nil {
+				err = pub.driver.Publish(key, b)
+			}
+			if err != nil {
+				pub.log.Errorf("populate(%s) write back of %s failed: %v",
+					name, key, err)
+			}
+		}
 	}
 	pub.km.restartCounter = restartCounter
 	pub.log.Tracef("populate(%s) done\n", name)
@@ -312,6 +353,14 @@ func (pub *PublicationImpl) DetermineDiffs(localCollection LocalCollection) []st
 		if err != nil {
 			pub.log.Fatalf("json Marshal in DetermineDiffs for origin key %s: %v", originKey, err)
 		}
+		// Stamp the schema version as on the persisted items so that
+		// a persistent subscriber doesn't migrate the current version
+		if pub.persistent {
+			originb, err = stampSchemaVersion(pub.topic, originb)
+			if err != nil {
+				pub.log.Fatalf("DetermineDiffs for origin key %s: %v", originKey, err)
+			}
+		}
 
 		local := lookupLocal(localCollection, originKey)
 		if local == nil {
@@ -372,6 +421,9 @@ func (pub *PublicationImpl) restartImpl(restarted bool) error {
 		return nil
 	}
 	pub.km.restartCounter = restartCounter
+	if pub.journal != nil {
+		pub.journal.recordRestart(pub, restartCounter)
+	}
 	// XXX lock on restarted to make sure it gets noticed?
 	// XXX bug?
 	// Implicit in updaters lock??
@@ -31,6 +31,14 @@ type SubscriptionOptions struct {
 	Ctx            interface{}
 	Persistent     bool
 	MyAgentName    string // For logging
+	// CoalesceWindow and MaxQueueLength enable a queue between the
+	// publisher and the agent where changes for the same key are merged.
+	// With a CoalesceWindow the changes are held for that long before
+	// being delivered. QueuePolicy determines what happens when
+	// MaxQueueLength is reached.
+	CoalesceWindow time.Duration
+	MaxQueueLength int
+	QueuePolicy    QueuePolicy
 }
 
 // SubCreateHandler is a handler to handle creates
@@ -68,6 +76,7 @@ type PubSub struct {
 	updaterList *Updaters
 	logger      *logrus.Logger
 	log         *base.LogObject
+	journal     *Journal
 }
 
 // New create a new `PubSub` with a given `Driver`.
@@ -79,6 +88,12 @@ func New(driver Driver, logger *logrus.Logger, log *base.LogObject) *PubSub {
 	}
 }
 
+// SetJournal makes the publications record all their changes to the
+// journal. Only applies to publications created after the call.
+func (p *PubSub) SetJournal(journal *Journal) {
+	p.journal = journal
+}
+
 // methods unique to this implementation
 
 // NewSubscription creates a new Subscription with given options
@@ -122,6 +137,25 @@ func (p *PubSub) NewSubscription(options SubscriptionOptions) (Subscription, err
 		myAgentName:         options.MyAgentName,
 		ps:                  p,
 	}
+	if options.CoalesceWindow != 0 || options.MaxQueueLength != 0 {
+		// The driver sends to the queue and the agent reads from out
+		out := make(chan Change)
+		sub.C = out
+		sub.queue = &changeQueue{
+			in:        changes,
+			out:       out,
+			window:    options.CoalesceWindow,
+			maxLength: options.MaxQueueLength,
+			policy:    options.QueuePolicy,
+			isKnown: func(key string) bool {
+				_, ok := sub.km.key.Load(key)
+				return ok
+			},
+			sent:     make(map[string]bool),
+			doneChan: make(chan struct{}),
+		}
+		go sub.queue.run()
+	}
 	name := sub.nameString()
 	global := options.AgentName == ""
 	driver, err := p.driver.Subscriber(global, name, topic, options.Persistent, changes)
@@ -129,6 +163,7 @@ func (p *PubSub) NewSubscription(options SubscriptionOptions) (Subscription, err
 		return sub, err
 	}
 	sub.driver = driver
+	registerSubscription(sub)
 
 	sub.log.Functionf("Subscribe(%s)\n", name)
 	if options.Activate {
@@ -175,6 +210,7 @@ func (p *PubSub) NewPublication(options PublicationOptions) (Publication, error)
 		persistent:  options.Persistent,
 		logger:      p.logger,
 		log:         p.log,
+		journal:     p.journal,
 	}
 	// create the driver
 	name := pub.nameString()
@@ -186,6 +222,7 @@ func (p *PubSub) NewPublication(options PublicationOptions) (Publication, error)
 		return pub, err
 	}
 	pub.driver = driver
+	registerPublication(pub)
 
 	pub.populate()
 	if pub.logger.GetLevel() == logrus.TraceLevel {
@@ -0,0 +1,229 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package pubsub
+
+import (
+	"sync"
+	"time"
+)
+
+// QueuePolicy determines what happens when the queue of a subscription
+// with a MaxQueueLength is full
+type QueuePolicy uint8
+
+const (
+	// QueueMerge only merges changes for the same key; if the queue is
+	// still full we stop reading from the publisher until there is room
+	QueueMerge QueuePolicy = iota
+	// QueueDropOldest drops the oldest queued Modify which is followed by
+	// a queued change for the same key to make room. The last Modify for
+	// a key is never dropped; if there is nothing to drop we stop reading
+	// as for QueueMerge. Suitable for topics such as metrics.
+	QueueDropOldest
+)
+
+// QueueStats are the counters for a subscription queue
+type QueueStats struct {
+	Queued   uint64 // Changes received from the publisher
+	Merged   uint64 // Changes which replaced a queued change for the key
+	Dropped  uint64 // Changes dropped due to QueueDropOldest
+	Depth    int    // Current number of queued changes
+	MaxDepth int    // Highest number of queued changes
+}
+
+// changeQueue sits between the driver and the agent for subscriptions with
+// a CoalesceWindow or a MaxQueueLength. Queued Modify and Delete changes
+// are merged by key so that the agent only sees the latest value.
+// Restart and Sync are never merged or dropped, and changes are not
+// merged across them to preserve the order of those.
+type changeQueue struct {
+	in        <-chan Change
+	out       chan<- Change
+	window    time.Duration
+	maxLength int
+	policy    QueuePolicy
+	isKnown   func(key string) bool // Does the agent have the key?
+	doneChan  chan struct{}
+
+	lock    sync.Mutex
+	pending []Change
+	// Keys for which a Modify was sent to the agent without a later
+	// Delete. Covers the ones which are in flight i.e., taken from out
+	// but not yet stored by the agent hence unknown to isKnown.
+	sent  map[string]bool
+	stats QueueStats
+}
+
+func isControl(change Change) bool {
+	return change.Operation == Restart || change.Operation == Sync
+}
+
+// enqueue adds the change, merging or dropping as needed. Returns false
+// if the queue is full and the change was not added.
+func (q *changeQueue) enqueue(change Change) bool {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	if !isControl(change) {
+		// Look for a change for the same key after the last control
+		for i := len(q.pending) - 1; i >= 0; i-- {
+			old := q.pending[i]
+			if isControl(old) {
+				break
+			}
+			if old.Key != change.Key {
+				continue
+			}
+			if old.Operation == Delete && change.Operation == Modify {
+				// Let the agent see the delete and the create
+				break
+			}
+			q.stats.Queued++
+			q.stats.Merged++
+			if change.Operation == Delete && !q.sent[change.Key] &&
+				!q.isKnown(change.Key) {
+				// The agent never saw the key
+				q.pending = append(q.pending[:i], q.pending[i+1:]...)
+			} else {
+				q.pending[i] = change
+			}
+			q.updateDepth()
+			return true
+		}
+	}
+	if q.maxLength != 0 && len(q.pending) >= q.maxLength {
+		if q.policy != QueueDropOldest || !q.dropOldest(change) {
+			return false
+		}
+	}
+	q.stats.Queued++
+	q.pending = append(q.pending, change)
+	q.updateDepth()
+	return true
+}
+
+// dropOldest removes the oldest Modify which is followed by another change
+// for the same key, either queued or the new change. Returns false if there
+// is none.
+func (q *changeQueue) dropOldest(change Change) bool {
+	for i, old := range q.pending {
+		if old.Operation != Modify {
+			continue
+		}
+		followed := !isControl(change) && change.Key == old.Key
+		for _, later := range q.pending[i+1:] {
+			if !isControl(later) && later.Key == old.Key {
+				followed = true
+				break
+			}
+		}
+		if followed {
+			q.pending = append(q.pending[:i], q.pending[i+1:]...)
+			q.stats.Dropped++
+			return true
+		}
+	}
+	return false
+}
+
+func (q *changeQueue) updateDepth() {
+	q.stats.Depth = len(q.pending)
+	if q.stats.Depth > q.stats.MaxDepth {
+		q.stats.MaxDepth = q.stats.Depth
+	}
+}
+
+func (q *changeQueue) head() (Change, bool) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	if len(q.pending) == 0 {
+		return Change{}, false
+	}
+	return q.pending[0], true
+}
+
+// pop removes the head after it was sent to the agent
+func (q *changeQueue) pop() {
+	q.lock.Lock()
+	switch change := q.pending[0]; change.Operation {
+	case Modify:
+		q.sent[change.Key] = true
+	case Delete:
+		delete(q.sent, change.Key)
+	}
+	q.pending = q.pending[1:]
+	q.updateDepth()
+	q.lock.Unlock()
+}
+
+func (q *changeQueue) getStats() QueueStats {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	return q.stats
+}
+
+// run moves changes from in to out until doneChan is closed.
+// With a window the changes are held until the window has passed since
+// the first change arrived in an empty queue.
+func (q *changeQueue) run() {
+	var blocked *Change // Change which did not fit in a full queue
+	var windowTimer *time.Timer
+	var windowC <-chan time.Time
+	ready := q.window == 0
+	inOpen := true
+	for {
+		in := q.in
+		if blocked != nil || !inOpen {
+			// Backpressure to the driver
+			in = nil
+		}
+		var out chan<- Change
+		next, ok := q.head()
+		if ok && ready {
+			out = q.out
+		}
+		select {
+		case <-q.doneChan:
+			if windowTimer != nil {
+				windowTimer.Stop()
+			}
+			return
+		case change, ok := <-in:
+			if !ok {
+				// The driver closed the channel on Stop
+				inOpen = false
+				break
+			}
+			if !q.enqueue(change) {
+				blocked = &change
+			}
+			if q.window == 0 {
+				break
+			}
+			_, nonEmpty := q.head()
+			switch {
+			case !nonEmpty:
+				// A delete removed the only queued change
+				if windowC != nil {
+					windowTimer.Stop()
+					windowC = nil
+				}
+				ready = false
+			case nonEmpty && !ready && windowC == nil:
+				windowTimer = time.NewTimer(q.window)
+				windowC = windowTimer.C
+			}
+		case <-windowC:
+			windowC = nil
+			ready = true
+		case out <- next:
+			q.pop()
+			if blocked != nil && q.enqueue(*blocked) {
+				blocked = nil
+			}
+			if _, ok := q.head(); !ok && q.window != 0 {
+				ready = false
+			}
+		}
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package pubsub
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestQueue(window time.Duration, maxLength int, policy QueuePolicy,
+	known map[string]bool) (chan Change, chan Change, *changeQueue) {
+
+	in := make(chan Change)
+	out := make(chan Change)
+	q := &changeQueue{
+		in:        in,
+		out:       out,
+		window:    window,
+		maxLength: maxLength,
+		policy:    policy,
+		isKnown:   func(key string) bool { return known[key] },
+		sent:      make(map[string]bool),
+		doneChan:  make(chan struct{}),
+	}
+	return in, out, q
+}
+
+func modify(key string, val string) Change {
+	return Change{Operation: Modify, Key: key, Value: []byte(val)}
+}
+
+func TestQueueMerge(t *testing.T) {
+	known := map[string]bool{"known": true}
+	_, _, q := newTestQueue(0, 0, QueueMerge, known)
+	assert.True(t, q.enqueue(modify("key1", "1")))
+	assert.True(t, q.enqueue(modify("key2", "1")))
+	assert.True(t, q.enqueue(modify("key1", "2")))
+	assert.True(t, q.enqueue(Change{Operation: Sync}))
+	// Not merged across the Sync
+	assert.True(t, q.enqueue(modify("key1", "3")))
+	// Delete of a key the agent never saw removes the Modify
+	assert.True(t, q.enqueue(Change{Operation: Delete, Key: "key1"}))
+	assert.True(t, q.enqueue(modify("known", "1")))
+	assert.True(t, q.enqueue(Change{Operation: Delete, Key: "known"}))
+	// Modify after Delete is kept separate
+	assert.True(t, q.enqueue(modify("known", "2")))
+	assert.Equal(t, []Change{
+		modify("key1", "2"),
+		modify("key2", "1"),
+		{Operation: Sync},
+		{Operation: Delete, Key: "known"},
+		modify("known", "2"),
+	}, q.pending)
+	stats := q.getStats()
+	assert.Equal(t, uint64(9), stats.Queued)
+	assert.Equal(t, uint64(3), stats.Merged)
+	assert.Equal(t, 5, stats.MaxDepth)
+}
+
+func TestQueueFull(t *testing.T) {
+	_, _, q := newTestQueue(0, 2, QueueMerge, nil)
+	assert.True(t, q.enqueue(modify("key1", "1")))
+	assert.True(t, q.enqueue(modify("key2", "1")))
+	// Merging is possible when full
+	assert.True(t, q.enqueue(modify("key2", "2")))
+	assert.False(t, q.enqueue(modify("key3", "1")))
+
+	_, _, q = newTestQueue(0, 3, QueueDropOldest, nil)
+	assert.True(t, q.enqueue(modify("key1", "1")))
+	assert.True(t, q.enqueue(Change{Operation: Restart, Key: "1"}))
+	assert.True(t, q.enqueue(modify("key2", "1")))
+	// The last Modify for a key is never dropped
+	assert.False(t, q.enqueue(modify("key3", "1")))
+	// Not merged across the Restart hence the first one can be dropped
+	assert.True(t, q.enqueue(modify("key1", "2")))
+	assert.Equal(t, []Change{{Operation: Restart, Key: "1"},
+		modify("key2", "1"), modify("key1", "2")}, q.pending)
+	assert.Equal(t, uint64(1), q.getStats().Dropped)
+}
+
+func TestQueueInFlight(t *testing.T) {
+	in, out, q := newTestQueue(0, 0, QueueMerge, nil)
+	go q.run()
+	defer close(q.doneChan)
+
+	// The agent took the create but hasn't stored it yet
+	in <- modify("key1", "1")
+	assert.Equal(t, modify("key1", "1"), <-out)
+	// Pause the agent until the modify and delete are both queued
+	q.lock.Lock()
+	q.pending = append(q.pending, modify("key1", "2"))
+	q.lock.Unlock()
+	in <- Change{Operation: Delete, Key: "key1"}
+	// The agent still has to see the delete
+	select {
+	case change := <-out:
+		assert.Equal(t, Change{Operation: Delete, Key: "key1"}, change)
+	case <-time.After(time.Second):
+		t.Fatalf("delete of key1 was dropped")
+	}
+}
+
+func TestQueueWindow(t *testing.T) {
+	in, out, q := newTestQueue(100*time.Millisecond, 0, QueueMerge, nil)
+	go q.run()
+	defer close(q.doneChan)
+
+	start := time.Now()
+	for i := 0; i < 10; i++ {
+		in <- modify("key1", string(rune('0'+i)))
+	}
+	change := <-out
+	assert.GreaterOrEqual(t, int64(time.Since(start)), int64(100*time.Millisecond))
+	assert.Equal(t, modify("key1", "9"), change)
+	select {
+	case change := <-out:
+		t.Fatalf("unexpected change %+v", change)
+	case <-time.After(200 * time.Millisecond):
+	}
+	assert.Equal(t, uint64(9), q.getStats().Merged)
+}
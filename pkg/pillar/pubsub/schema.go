@@ -0,0 +1,127 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package pubsub
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+)
+
+const (
+	// tagSchemaVersion is the json field we add to the persisted items
+	// of topics which have registered migrations. Items without it are
+	// at version zero.
+	tagSchemaVersion = "pubsub-schema-version"
+)
+
+// SchemaMigration converts the json for an item of a topic from one
+// schema version to the next
+type SchemaMigration func(log *base.LogObject, key string, in []byte) ([]byte, error)
+
+// schemaRegistry has the migrations for each topic; migrations[i] converts
+// from version i to version i+1 hence the current version is the length.
+var schemaRegistry = struct {
+	sync.Mutex
+	migrations map[string][]SchemaMigration
+}{migrations: make(map[string][]SchemaMigration)}
+
+// RegisterSchemaMigration registers the migration from fromVersion to
+// fromVersion+1 for the topic of topicType. Migrations must be registered
+// in order starting with fromVersion zero. Usually called from an init().
+func RegisterSchemaMigration(topicType interface{}, fromVersion int,
+	migration SchemaMigration) error {
+
+	topic := TypeToName(topicType)
+	schemaRegistry.Lock()
+	defer schemaRegistry.Unlock()
+	migrations := schemaRegistry.migrations[topic]
+	if fromVersion != len(migrations) {
+		return fmt.Errorf("RegisterSchemaMigration(%s): expected fromVersion %d got %d",
+			topic, len(migrations), fromVersion)
+	}
+	schemaRegistry.migrations[topic] = append(migrations, migration)
+	return nil
+}
+
+// SchemaVersion returns the current schema version for the topic of
+// topicType, which is zero if no migrations are registered.
+func SchemaVersion(topicType interface{}) int {
+	return schemaVersion(TypeToName(topicType))
+}
+
+func schemaVersion(topic string) int {
+	schemaRegistry.Lock()
+	defer schemaRegistry.Unlock()
+	return len(schemaRegistry.migrations[topic])
+}
+
+// stampSchemaVersion adds the current schema version to the json for an
+// item which is about to be persisted. Topics without migrations are left
+// unchanged so that their files look as they always did.
+func stampSchemaVersion(topic string, b []byte) ([]byte, error) {
+	version := schemaVersion(topic)
+	if version == 0 {
+		return b, nil
+	}
+	var tree map[string]json.RawMessage
+	if err := json.Unmarshal(b, &tree); err != nil {
+		return nil, fmt.Errorf("stampSchemaVersion(%s): %v", topic, err)
+	}
+	vb, err := json.Marshal(version)
+	if err != nil {
+		return nil, fmt.Errorf("stampSchemaVersion(%s): %v", topic, err)
+	}
+	tree[tagSchemaVersion] = vb
+	return json.Marshal(tree)
+}
+
+// itemSchemaVersion returns the version stamped on the json; zero if none
+func itemSchemaVersion(b []byte) (int, error) {
+	var stamp struct {
+		Version int `json:"pubsub-schema-version"`
+	}
+	if err := json.Unmarshal(b, &stamp); err != nil {
+		return 0, err
+	}
+	return stamp.Version, nil
+}
+
+// migrateSchema runs the migrations needed to bring the json for an item
+// up to the current schema version of the topic. Returns true if any
+// migration was applied. An item from a newer version (for instance after
+// a downgrade of EVE) is returned unchanged since it is up to the
+// json.Unmarshal of the current type to make sense of it.
+func migrateSchema(log *base.LogObject, topic string, key string, b []byte) ([]byte, bool, error) {
+	schemaRegistry.Lock()
+	migrations := schemaRegistry.migrations[topic]
+	schemaRegistry.Unlock()
+	if len(migrations) == 0 {
+		return b, false, nil
+	}
+	version, err := itemSchemaVersion(b)
+	if err != nil {
+		return b, false, fmt.Errorf("migrateSchema(%s/%s): %v",
+			topic, key, err)
+	}
+	if version > len(migrations) {
+		log.Warnf("migrateSchema(%s/%s): version %d newer than %d",
+			topic, key, version, len(migrations))
+		return b, false, nil
+	}
+	migrated := false
+	for ; version < len(migrations); version++ {
+		log.Noticef("migrateSchema(%s/%s): from version %d",
+			topic, key, version)
+		b, err = migrations[version](log, key, b)
+		if err != nil {
+			return b, migrated, fmt.Errorf("migrateSchema(%s/%s) from version %d: %v",
+				topic, key, version, err)
+		}
+		migrated = true
+	}
+	return b, migrated, nil
+}
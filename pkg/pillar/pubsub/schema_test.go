@@ -0,0 +1,177 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package pubsub_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub/memdriver"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub/socketdriver"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+// schemaItem used to have FieldA which was renamed to FieldB, and then
+// FieldC was added with a default of "default"
+type schemaItem struct {
+	FieldB string
+	FieldC string
+}
+
+func renameFieldA(log *base.LogObject, key string, in []byte) ([]byte, error) {
+	var tree map[string]interface{}
+	if err := json.Unmarshal(in, &tree); err != nil {
+		return nil, err
+	}
+	tree["FieldB"] = tree["FieldA"]
+	delete(tree, "FieldA")
+	return json.Marshal(tree)
+}
+
+func defaultFieldC(log *base.LogObject, key string, in []byte) ([]byte, error) {
+	var tree map[string]interface{}
+	if err := json.Unmarshal(in, &tree); err != nil {
+		return nil, err
+	}
+	tree["FieldC"] = "default"
+	return json.Marshal(tree)
+}
+
+func TestSchemaMigration(t *testing.T) {
+	// Run in a unique directory
+	rootPath, err := ioutil.TempDir("", "schema_test")
+	if err != nil {
+		t.Fatalf("TempDir failed: %s", err)
+	}
+	defer os.RemoveAll(rootPath)
+
+	logger := logrus.StandardLogger()
+	logger.SetLevel(logrus.ErrorLevel)
+	log := base.NewSourceLogObject(logger, "test", 1234)
+	driver := socketdriver.SocketDriver{
+		Logger:  logger,
+		Log:     log,
+		RootDir: rootPath,
+	}
+	ps := pubsub.New(&driver, logger, log)
+
+	// The registry is global hence reset it for reruns with -count
+	pubsub.ResetSchemaMigrations(schemaItem{})
+	defer pubsub.ResetSchemaMigrations(schemaItem{})
+	assert.Equal(t, 0, pubsub.SchemaVersion(schemaItem{}))
+	assert.Nil(t, pubsub.RegisterSchemaMigration(schemaItem{}, 0, renameFieldA))
+	assert.NotNil(t, pubsub.RegisterSchemaMigration(schemaItem{}, 0, defaultFieldC))
+	assert.Nil(t, pubsub.RegisterSchemaMigration(schemaItem{}, 1, defaultFieldC))
+	assert.Equal(t, 2, pubsub.SchemaVersion(schemaItem{}))
+
+	// Items persisted by an old version without any stamp
+	dirName := filepath.Join(rootPath, "persist/status/testagent/schemaItem")
+	assert.Nil(t, os.MkdirAll(dirName, 0700))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dirName, "key0.json"),
+		[]byte(`{"FieldA":"item0"}`), 0600))
+	// and one at version 1
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dirName, "key1.json"),
+		[]byte(`{"FieldB":"item1","pubsub-schema-version":1}`), 0600))
+
+	// A persistent subscription loads and migrates
+	sub, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName:  "testagent",
+		TopicImpl:  schemaItem{},
+		Persistent: true,
+	})
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+	sub.Activate()
+	val, err := sub.Get("key0")
+	assert.Nil(t, err)
+	assert.Equal(t, schemaItem{FieldB: "item0", FieldC: "default"}, val)
+	val, err = sub.Get("key1")
+	assert.Nil(t, err)
+	assert.Equal(t, schemaItem{FieldB: "item1", FieldC: "default"}, val)
+	sub.Close()
+
+	pub, err := ps.NewPublication(pubsub.PublicationOptions{
+		AgentName:  "testagent",
+		TopicType:  schemaItem{},
+		Persistent: true,
+	})
+	if err != nil {
+		t.Fatalf("unable to publish: %v", err)
+	}
+	val, err = pub.Get("key0")
+	assert.Nil(t, err)
+	assert.Equal(t, schemaItem{FieldB: "item0", FieldC: "default"}, val)
+
+	// The files were written back with the current version
+	for _, key := range []string{"key0", "key1"} {
+		b, err := ioutil.ReadFile(filepath.Join(dirName, key+".json"))
+		assert.Nil(t, err)
+		var stamp map[string]interface{}
+		assert.Nil(t, json.Unmarshal(b, &stamp))
+		assert.Equal(t, float64(2), stamp["pubsub-schema-version"])
+		assert.Nil(t, stamp["FieldA"])
+	}
+	pub.Publish("key2", schemaItem{FieldB: "item2", FieldC: "set"})
+	b, err := ioutil.ReadFile(filepath.Join(dirName, "key2.json"))
+	assert.Nil(t, err)
+	assert.Contains(t, string(b), `"pubsub-schema-version":2`)
+	pub.Close()
+
+	// Items which are not persisted are not stamped
+	pub, err = ps.NewPublication(pubsub.PublicationOptions{
+		AgentName: "otheragent",
+		TopicType: schemaItem{},
+	})
+	if err != nil {
+		t.Fatalf("unable to publish: %v", err)
+	}
+	pub.Publish("key3", schemaItem{FieldB: "item3"})
+	b, err = ioutil.ReadFile(filepath.Join(rootPath,
+		"var/run/otheragent/schemaItem/key3.json"))
+	assert.Nil(t, err)
+	assert.NotContains(t, string(b), "pubsub-schema-version")
+	pub.Close()
+
+	// Items sent live to a persistent subscriber are not migrated again
+	memDriver := memdriver.MemoryDriver{
+		Logger:  logger,
+		Log:     log,
+		RootDir: rootPath,
+	}
+	ps = pubsub.New(&memDriver, logger, log)
+	pub, err = ps.NewPublication(pubsub.PublicationOptions{
+		AgentName:  "liveagent",
+		TopicType:  schemaItem{},
+		Persistent: true,
+	})
+	if err != nil {
+		t.Fatalf("unable to publish: %v", err)
+	}
+	pub.Publish("key4", schemaItem{FieldB: "item4", FieldC: "set"})
+	sub, err = ps.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName:  "liveagent",
+		TopicImpl:  schemaItem{},
+		Persistent: true,
+		Activate:   true,
+	})
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+	for !sub.Synchronized() {
+		change := <-sub.MsgChan()
+		sub.ProcessChange(change)
+	}
+	val, err = sub.Get("key4")
+	assert.Nil(t, err)
+	assert.Equal(t, schemaItem{FieldB: "item4", FieldC: "set"}, val)
+	sub.Close()
+	pub.Close()
+}
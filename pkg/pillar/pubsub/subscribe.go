@@ -41,6 +41,8 @@ type SubscriptionImpl struct {
 	log          *base.LogObject
 	myAgentName  string // For logging
 	ps           *PubSub
+	queue        *changeQueue // If CoalesceWindow or MaxQueueLength
+	topicStats
 }
 
 // MsgChan return the Message Channel for the Subscription.
@@ -59,6 +61,9 @@ func (sub *SubscriptionImpl) Activate() error {
 // Close stops the subscription and removes the content
 func (sub *SubscriptionImpl) Close() error {
 	sub.driver.Stop()
+	if sub.queue != nil {
+		close(sub.queue.doneChan)
+	}
 	items := sub.GetAll()
 	for key := range items {
 		sub.log.Functionf("Close(%s) unloading key %s",
@@ -67,6 +72,7 @@ func (sub *SubscriptionImpl) Close() error {
 	}
 	handleRestart(sub, 0)
 	handleSynchronized(sub, false)
+	unregisterSubscription(sub)
 	return nil
 }
 
@@ -132,6 +138,7 @@ func (sub *SubscriptionImpl) ProcessChange(change Change) {
 		handleModify(sub, change.Key, change.Value)
 	}
 	sub.ps.CheckMaxTimeTopic(sub.myAgentName, sub.topic, start, sub.MaxProcessTimeWarn, sub.MaxProcessTimeError)
+	sub.recordHandler(time.Since(start), sub.MaxProcessTimeWarn, sub.MaxProcessTimeError)
 }
 
 // Get - Get object with specified Key from this Subscription.
@@ -177,6 +184,15 @@ func (sub *SubscriptionImpl) Synchronized() bool {
 	return sub.synchronized
 }
 
+// QueueStats returns the counters for the queue, if the subscription
+// has a CoalesceWindow or MaxQueueLength
+func (sub *SubscriptionImpl) QueueStats() (QueueStats, bool) {
+	if sub.queue == nil {
+		return QueueStats{}, false
+	}
+	return sub.queue.getStats(), true
+}
+
 // Topic returns the string definiting the topic
 func (sub *SubscriptionImpl) Topic() string {
 	return sub.topic
@@ -226,6 +242,15 @@ func handleModify(ctxArg interface{}, key string, itemcb []byte) {
 		sub.log.Errorln(errStr)
 		return
 	}
+	// Persisted items might be from an older schema version
+	if sub.Persistent {
+		itemcb, _, err = migrateSchema(sub.log, sub.topic, key, itemcb)
+		if err != nil {
+			errStr := fmt.Sprintf("handleModify(%s): %s", name, err)
+			sub.log.Errorln(errStr)
+			return
+		}
+	}
 	item, err := parseTemplate(sub.log, itemcb, sub.topicType)
 	if err != nil {
 		errStr := fmt.Sprintf("handleModify(%s): json failed %s",
@@ -311,7 +336,10 @@ func handleRestart(ctxArg interface{}, restartCounter int) {
 		sub.log.Tracef("pubsub.handleRestart(%s) value unchanged\n", name)
 		return
 	}
+	// The lock is for the reads by introspection
+	sub.statsLock.Lock()
 	sub.km.restartCounter = restartCounter
+	sub.statsLock.Unlock()
 	if sub.RestartHandler != nil {
 		(sub.RestartHandler)(sub.userCtx, restartCounter)
 	}
@@ -327,7 +355,9 @@ func handleSynchronized(ctxArg interface{}, synchronized bool) {
 		sub.log.Tracef("pubsub.handleSynchronized(%s) value unchanged\n", name)
 		return
 	}
+	sub.statsLock.Lock()
 	sub.synchronized = synchronized
+	sub.statsLock.Unlock()
 	if sub.SynchronizedHandler != nil {
 		(sub.SynchronizedHandler)(sub.userCtx, synchronized)
 	}
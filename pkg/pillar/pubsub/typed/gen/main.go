@@ -0,0 +1,263 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// gen generates the typed wrappers in the typed package. It is run by
+// go generate with the output file and the names of the structs in the
+// types package for which to generate wrappers.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"text/template"
+)
+
+const header = `// Code generated by pubsub/typed/gen; DO NOT EDIT.
+
+package typed
+
+import (
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/pubsub"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+`
+
+var wrapper = template.Must(template.New("wrapper").Parse(`
+// {{.}}Publication is a typed pubsub.Publication for types.{{.}}
+type {{.}}Publication struct {
+	pub pubsub.Publication
+}
+
+// New{{.}}Publication creates a publication for types.{{.}}
+func New{{.}}Publication(ps *pubsub.PubSub, options PublicationOptions) ({{.}}Publication, error) {
+	pub, err := ps.NewPublication(pubsub.PublicationOptions{
+		AgentName:  options.AgentName,
+		AgentScope: options.AgentScope,
+		TopicType:  types.{{.}}{},
+		Persistent: options.Persistent,
+	})
+	return {{.}}Publication{pub: pub}, err
+}
+
+// Untyped returns the underlying pubsub.Publication
+func (p {{.}}Publication) Untyped() pubsub.Publication {
+	return p.pub
+}
+
+// CheckMaxSize returns an error if the item is too large
+func (p {{.}}Publication) CheckMaxSize(key string, item types.{{.}}) error {
+	return p.pub.CheckMaxSize(key, item)
+}
+
+// Publish an item
+func (p {{.}}Publication) Publish(key string, item types.{{.}}) error {
+	return p.pub.Publish(key, item)
+}
+
+// Unpublish an item
+func (p {{.}}Publication) Unpublish(key string) error {
+	return p.pub.Unpublish(key)
+}
+
+// SignalRestarted signal the publisher has started one more time
+func (p {{.}}Publication) SignalRestarted() error {
+	return p.pub.SignalRestarted()
+}
+
+// ClearRestarted clear the restarted flag
+func (p {{.}}Publication) ClearRestarted() error {
+	return p.pub.ClearRestarted()
+}
+
+// Get returns a copy of the item and whether it was found
+func (p {{.}}Publication) Get(key string) (types.{{.}}, bool) {
+	item, err := p.pub.Get(key)
+	if err != nil {
+		return types.{{.}}{}, false
+	}
+	return item.(types.{{.}}), true
+}
+
+// GetAll returns a copy of all the items
+func (p {{.}}Publication) GetAll() map[string]types.{{.}} {
+	result := make(map[string]types.{{.}})
+	for key, item := range p.pub.GetAll() {
+		result[key] = item.(types.{{.}})
+	}
+	return result
+}
+
+// Iterate calls function for each item until it returns false
+func (p {{.}}Publication) Iterate(function func(key string, item types.{{.}}) bool) {
+	p.pub.Iterate(func(key string, item interface{}) bool {
+		return function(key, item.(types.{{.}}))
+	})
+}
+
+// Close the publication
+func (p {{.}}Publication) Close() error {
+	return p.pub.Close()
+}
+
+// {{.}}SubscriptionOptions are the options for a {{.}}Subscription
+type {{.}}SubscriptionOptions struct {
+	CreateHandler  func(ctx interface{}, key string, item types.{{.}})
+	ModifyHandler  func(ctx interface{}, key string, item types.{{.}}, oldItem types.{{.}})
+	DeleteHandler  func(ctx interface{}, key string, item types.{{.}})
+	RestartHandler pubsub.SubRestartHandler
+	SyncHandler    pubsub.SubSyncHandler
+	WarningTime    time.Duration
+	ErrorTime      time.Duration
+	AgentName      string
+	AgentScope     string
+	Activate       bool
+	Ctx            interface{}
+	Persistent     bool
+	MyAgentName    string // For logging
+	CoalesceWindow time.Duration
+	MaxQueueLength int
+	QueuePolicy    pubsub.QueuePolicy
+}
+
+// {{.}}Subscription is a typed pubsub.Subscription for types.{{.}}
+type {{.}}Subscription struct {
+	sub pubsub.Subscription
+}
+
+// New{{.}}Subscription creates a subscription for types.{{.}}
+func New{{.}}Subscription(ps *pubsub.PubSub, options {{.}}SubscriptionOptions) ({{.}}Subscription, error) {
+	subOptions := pubsub.SubscriptionOptions{
+		RestartHandler: options.RestartHandler,
+		SyncHandler:    options.SyncHandler,
+		WarningTime:    options.WarningTime,
+		ErrorTime:      options.ErrorTime,
+		AgentName:      options.AgentName,
+		AgentScope:     options.AgentScope,
+		TopicImpl:      types.{{.}}{},
+		Activate:       options.Activate,
+		Ctx:            options.Ctx,
+		Persistent:     options.Persistent,
+		MyAgentName:    options.MyAgentName,
+		CoalesceWindow: options.CoalesceWindow,
+		MaxQueueLength: options.MaxQueueLength,
+		QueuePolicy:    options.QueuePolicy,
+	}
+	if options.CreateHandler != nil {
+		handler := options.CreateHandler
+		subOptions.CreateHandler = func(ctx interface{}, key string, item interface{}) {
+			handler(ctx, key, item.(types.{{.}}))
+		}
+	}
+	if options.ModifyHandler != nil {
+		handler := options.ModifyHandler
+		subOptions.ModifyHandler = func(ctx interface{}, key string, item interface{}, oldItem interface{}) {
+			handler(ctx, key, item.(types.{{.}}), oldItem.(types.{{.}}))
+		}
+	}
+	if options.DeleteHandler != nil {
+		handler := options.DeleteHandler
+		subOptions.DeleteHandler = func(ctx interface{}, key string, item interface{}) {
+			handler(ctx, key, item.(types.{{.}}))
+		}
+	}
+	sub, err := ps.NewSubscription(subOptions)
+	return {{.}}Subscription{sub: sub}, err
+}
+
+// Untyped returns the underlying pubsub.Subscription
+func (s {{.}}Subscription) Untyped() pubsub.Subscription {
+	return s.sub
+}
+
+// Get returns the item and whether it was found
+func (s {{.}}Subscription) Get(key string) (types.{{.}}, bool) {
+	item, err := s.sub.Get(key)
+	if err != nil {
+		return types.{{.}}{}, false
+	}
+	return item.(types.{{.}}), true
+}
+
+// GetAll returns all the items
+func (s {{.}}Subscription) GetAll() map[string]types.{{.}} {
+	result := make(map[string]types.{{.}})
+	for key, item := range s.sub.GetAll() {
+		result[key] = item.(types.{{.}})
+	}
+	return result
+}
+
+// Iterate calls function for each item until it returns false
+func (s {{.}}Subscription) Iterate(function func(key string, item types.{{.}}) bool) {
+	s.sub.Iterate(func(key string, item interface{}) bool {
+		return function(key, item.(types.{{.}}))
+	})
+}
+
+// Restarted reports if the publisher has restarted
+func (s {{.}}Subscription) Restarted() bool {
+	return s.sub.Restarted()
+}
+
+// RestartCounter reports how many times the publisher has restarted
+func (s {{.}}Subscription) RestartCounter() int {
+	return s.sub.RestartCounter()
+}
+
+// Synchronized reports if the initial items have been received
+func (s {{.}}Subscription) Synchronized() bool {
+	return s.sub.Synchronized()
+}
+
+// ProcessChange processes a change from MsgChan
+func (s {{.}}Subscription) ProcessChange(change pubsub.Change) {
+	s.sub.ProcessChange(change)
+}
+
+// MsgChan returns the channel of changes
+func (s {{.}}Subscription) MsgChan() <-chan pubsub.Change {
+	return s.sub.MsgChan()
+}
+
+// Activate starts the subscription
+func (s {{.}}Subscription) Activate() error {
+	return s.sub.Activate()
+}
+
+// Close stops the subscription
+func (s {{.}}Subscription) Close() error {
+	return s.sub.Close()
+}
+`))
+
+func main() {
+	output := flag.String("o", "", "output file")
+	flag.Parse()
+	if *output == "" || flag.NArg() == 0 {
+		fmt.Fprintf(os.Stderr, "usage: gen -o file TypeName...\n")
+		os.Exit(1)
+	}
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	for _, typeName := range flag.Args() {
+		if err := wrapper.Execute(&buf, typeName); err != nil {
+			fmt.Fprintf(os.Stderr, "template for %s: %v\n", typeName, err)
+			os.Exit(1)
+		}
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "format: %v\n", err)
+		os.Exit(1)
+	}
+	if err := ioutil.WriteFile(*output, src, 0644); err != nil {
+		fmt.Fprintf(os.Stderr, "write: %v\n", err)
+		os.Exit(1)
+	}
+}
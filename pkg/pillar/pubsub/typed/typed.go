@@ -0,0 +1,22 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package typed provides type-safe wrappers of pubsub.Publication and
+// pubsub.Subscription for the structs in the types package, so that using
+// the wrong type is caught at compile time instead of by a failed type
+// assertion. The wire format is unchanged; Untyped() returns the underlying
+// pubsub object for interoperability with existing code.
+//
+// The wrappers are generated. To add a type, add it to the go:generate
+// line below and run go generate.
+package typed
+
+//go:generate go run ./gen -o zz_generated.go AppInstanceConfig AppInstanceStatus DomainConfig DomainStatus DomainMetric VolumeConfig VolumeStatus
+
+// PublicationOptions are the options for a typed publication.
+// The topic is determined by the type.
+type PublicationOptions struct {
+	AgentName  string
+	AgentScope string
+	Persistent bool
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package typed_test
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub/memdriver"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub/typed"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTypedPubSub(t *testing.T) {
+	rootPath, err := ioutil.TempDir("", "typed_test")
+	if err != nil {
+		t.Fatalf("TempDir failed: %s", err)
+	}
+	defer os.RemoveAll(rootPath)
+
+	logger := logrus.StandardLogger()
+	logger.SetLevel(logrus.ErrorLevel)
+	log := base.NewSourceLogObject(logger, "test", 1234)
+	ps := pubsub.New(&memdriver.MemoryDriver{Logger: logger, Log: log,
+		RootDir: rootPath}, logger, log)
+
+	pub, err := typed.NewVolumeStatusPublication(ps,
+		typed.PublicationOptions{AgentName: "volumemgr"})
+	if err != nil {
+		t.Fatalf("unable to publish: %v", err)
+	}
+	var created, modified []string
+	sub, err := typed.NewVolumeStatusSubscription(ps,
+		typed.VolumeStatusSubscriptionOptions{
+			AgentName: "volumemgr",
+			CreateHandler: func(ctx interface{}, key string, status types.VolumeStatus) {
+				created = append(created, status.DisplayName)
+			},
+			ModifyHandler: func(ctx interface{}, key string, status types.VolumeStatus, oldStatus types.VolumeStatus) {
+				modified = append(modified, oldStatus.DisplayName+"->"+status.DisplayName)
+			},
+			Activate: true,
+		})
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+	assert.Nil(t, pub.Publish("key1", types.VolumeStatus{DisplayName: "vol1"}))
+	for !sub.Synchronized() {
+		sub.ProcessChange(<-sub.MsgChan())
+	}
+	assert.Nil(t, pub.Publish("key1", types.VolumeStatus{DisplayName: "vol2"}))
+	for len(modified) == 0 {
+		sub.ProcessChange(<-sub.MsgChan())
+	}
+	assert.Equal(t, []string{"vol1"}, created)
+	assert.Equal(t, []string{"vol1->vol2"}, modified)
+
+	status, ok := sub.Get("key1")
+	assert.True(t, ok)
+	assert.Equal(t, "vol2", status.DisplayName)
+	_, ok = sub.Get("key2")
+	assert.False(t, ok)
+	assert.Equal(t, 1, len(pub.GetAll()))
+	count := 0
+	sub.Iterate(func(key string, status types.VolumeStatus) bool {
+		count++
+		return true
+	})
+	assert.Equal(t, 1, count)
+	sub.Close()
+	pub.Close()
+}
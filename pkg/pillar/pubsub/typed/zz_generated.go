@@ -0,0 +1,1452 @@
+// Code generated by pubsub/typed/gen; DO NOT EDIT.
+
+package typed
+
+import (
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/pubsub"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+// AppInstanceConfigPublication is a typed pubsub.Publication for types.AppInstanceConfig
+type AppInstanceConfigPublication struct {
+	pub pubsub.Publication
+}
+
+// NewAppInstanceConfigPublication creates a publication for types.AppInstanceConfig
+func NewAppInstanceConfigPublication(ps *pubsub.PubSub, options PublicationOptions) (AppInstanceConfigPublication, error) {
+	pub, err := ps.NewPublication(pubsub.PublicationOptions{
+		AgentName:  options.AgentName,
+		AgentScope: options.AgentScope,
+		TopicType:  types.AppInstanceConfig{},
+		Persistent: options.Persistent,
+	})
+	return AppInstanceConfigPublication{pub: pub}, err
+}
+
+// Untyped returns the underlying pubsub.Publication
+func (p AppInstanceConfigPublication) Untyped() pubsub.Publication {
+	return p.pub
+}
+
+// CheckMaxSize returns an error if the item is too large
+func (p AppInstanceConfigPublication) CheckMaxSize(key string, item types.AppInstanceConfig) error {
+	return p.pub.CheckMaxSize(key, item)
+}
+
+// Publish an item
+func (p AppInstanceConfigPublication) Publish(key string, item types.AppInstanceConfig) error {
+	return p.pub.Publish(key, item)
+}
+
+// Unpublish an item
+func (p AppInstanceConfigPublication) Unpublish(key string) error {
+	return p.pub.Unpublish(key)
+}
+
+// SignalRestarted signal the publisher has started one more time
+func (p AppInstanceConfigPublication) SignalRestarted() error {
+	return p.pub.SignalRestarted()
+}
+
+// ClearRestarted clear the restarted flag
+func (p AppInstanceConfigPublication) ClearRestarted() error {
+	return p.pub.ClearRestarted()
+}
+
+// Get returns a copy of the item and whether it was found
+func (p AppInstanceConfigPublication) Get(key string) (types.AppInstanceConfig, bool) {
+	item, err := p.pub.Get(key)
+	if err != nil {
+		return types.AppInstanceConfig{}, false
+	}
+	return item.(types.AppInstanceConfig), true
+}
+
+// GetAll returns a copy of all the items
+func (p AppInstanceConfigPublication) GetAll() map[string]types.AppInstanceConfig {
+	result := make(map[string]types.AppInstanceConfig)
+	for key, item := range p.pub.GetAll() {
+		result[key] = item.(types.AppInstanceConfig)
+	}
+	return result
+}
+
+// Iterate calls function for each item until it returns false
+func (p AppInstanceConfigPublication) Iterate(function func(key string, item types.AppInstanceConfig) bool) {
+	p.pub.Iterate(func(key string, item interface{}) bool {
+		return function(key, item.(types.AppInstanceConfig))
+	})
+}
+
+// Close the publication
+func (p AppInstanceConfigPublication) Close() error {
+	return p.pub.Close()
+}
+
+// AppInstanceConfigSubscriptionOptions are the options for a AppInstanceConfigSubscription
+type AppInstanceConfigSubscriptionOptions struct {
+	CreateHandler  func(ctx interface{}, key string, item types.AppInstanceConfig)
+	ModifyHandler  func(ctx interface{}, key string, item types.AppInstanceConfig, oldItem types.AppInstanceConfig)
+	DeleteHandler  func(ctx interface{}, key string, item types.AppInstanceConfig)
+	RestartHandler pubsub.SubRestartHandler
+	SyncHandler    pubsub.SubSyncHandler
+	WarningTime    time.Duration
+	ErrorTime      time.Duration
+	AgentName      string
+	AgentScope     string
+	Activate       bool
+	Ctx            interface{}
+	Persistent     bool
+	MyAgentName    string // For logging
+	CoalesceWindow time.Duration
+	MaxQueueLength int
+	QueuePolicy    pubsub.QueuePolicy
+}
+
+// AppInstanceConfigSubscription is a typed pubsub.Subscription for types.AppInstanceConfig
+type AppInstanceConfigSubscription struct {
+	sub pubsub.Subscription
+}
+
+// NewAppInstanceConfigSubscription creates a subscription for types.AppInstanceConfig
+func NewAppInstanceConfigSubscription(ps *pubsub.PubSub, options AppInstanceConfigSubscriptionOptions) (AppInstanceConfigSubscription, error) {
+	subOptions := pubsub.SubscriptionOptions{
+		RestartHandler: options.RestartHandler,
+		SyncHandler:    options.SyncHandler,
+		WarningTime:    options.WarningTime,
+		ErrorTime:      options.ErrorTime,
+		AgentName:      options.AgentName,
+		AgentScope:     options.AgentScope,
+		TopicImpl:      types.AppInstanceConfig{},
+		Activate:       options.Activate,
+		Ctx:            options.Ctx,
+		Persistent:     options.Persistent,
+		MyAgentName:    options.MyAgentName,
+		CoalesceWindow: options.CoalesceWindow,
+		MaxQueueLength: options.MaxQueueLength,
+		QueuePolicy:    options.QueuePolicy,
+	}
+	if options.CreateHandler != nil {
+		handler := options.CreateHandler
+		subOptions.CreateHandler = func(ctx interface{}, key string, item interface{}) {
+			handler(ctx, key, item.(types.AppInstanceConfig))
+		}
+	}
+	if options.ModifyHandler != nil {
+		handler := options.ModifyHandler
+		subOptions.ModifyHandler = func(ctx interface{}, key string, item interface{}, oldItem interface{}) {
+			handler(ctx, key, item.(types.AppInstanceConfig), oldItem.(types.AppInstanceConfig))
+		}
+	}
+	if options.DeleteHandler != nil {
+		handler := options.DeleteHandler
+		subOptions.DeleteHandler = func(ctx interface{}, key string, item interface{}) {
+			handler(ctx, key, item.(types.AppInstanceConfig))
+		}
+	}
+	sub, err := ps.NewSubscription(subOptions)
+	return AppInstanceConfigSubscription{sub: sub}, err
+}
+
+// Untyped returns the underlying pubsub.Subscription
+func (s AppInstanceConfigSubscription) Untyped() pubsub.Subscription {
+	return s.sub
+}
+
+// Get returns the item and whether it was found
+func (s AppInstanceConfigSubscription) Get(key string) (types.AppInstanceConfig, bool) {
+	item, err := s.sub.Get(key)
+	if err != nil {
+		return types.AppInstanceConfig{}, false
+	}
+	return item.(types.AppInstanceConfig), true
+}
+
+// GetAll returns all the items
+func (s AppInstanceConfigSubscription) GetAll() map[string]types.AppInstanceConfig {
+	result := make(map[string]types.AppInstanceConfig)
+	for key, item := range s.sub.GetAll() {
+		result[key] = item.(types.AppInstanceConfig)
+	}
+	return result
+}
+
+// Iterate calls function for each item until it returns false
+func (s AppInstanceConfigSubscription) Iterate(function func(key string, item types.AppInstanceConfig) bool) {
+	s.sub.Iterate(func(key string, item interface{}) bool {
+		return function(key, item.(types.AppInstanceConfig))
+	})
+}
+
+// Restarted reports if the publisher has restarted
+func (s AppInstanceConfigSubscription) Restarted() bool {
+	return s.sub.Restarted()
+}
+
+// RestartCounter reports how many times the publisher has restarted
+func (s AppInstanceConfigSubscription) RestartCounter() int {
+	return s.sub.RestartCounter()
+}
+
+// Synchronized reports if the initial items have been received
+func (s AppInstanceConfigSubscription) Synchronized() bool {
+	return s.sub.Synchronized()
+}
+
+// ProcessChange processes a change from MsgChan
+func (s AppInstanceConfigSubscription) ProcessChange(change pubsub.Change) {
+	s.sub.ProcessChange(change)
+}
+
+// MsgChan returns the channel of changes
+func (s AppInstanceConfigSubscription) MsgChan() <-chan pubsub.Change {
+	return s.sub.MsgChan()
+}
+
+// Activate starts the subscription
+func (s AppInstanceConfigSubscription) Activate() error {
+	return s.sub.Activate()
+}
+
+// Close stops the subscription
+func (s AppInstanceConfigSubscription) Close() error {
+	return s.sub.Close()
+}
+
+// AppInstanceStatusPublication is a typed pubsub.Publication for types.AppInstanceStatus
+type AppInstanceStatusPublication struct {
+	pub pubsub.Publication
+}
+
+// NewAppInstanceStatusPublication creates a publication for types.AppInstanceStatus
+func NewAppInstanceStatusPublication(ps *pubsub.PubSub, options PublicationOptions) (AppInstanceStatusPublication, error) {
+	pub, err := ps.NewPublication(pubsub.PublicationOptions{
+		AgentName:  options.AgentName,
+		AgentScope: options.AgentScope,
+		TopicType:  types.AppInstanceStatus{},
+		Persistent: options.Persistent,
+	})
+	return AppInstanceStatusPublication{pub: pub}, err
+}
+
+// Untyped returns the underlying pubsub.Publication
+func (p AppInstanceStatusPublication) Untyped() pubsub.Publication {
+	return p.pub
+}
+
+// CheckMaxSize returns an error if the item is too large
+func (p AppInstanceStatusPublication) CheckMaxSize(key string, item types.AppInstanceStatus) error {
+	return p.pub.CheckMaxSize(key, item)
+}
+
+// Publish an item
+func (p AppInstanceStatusPublication) Publish(key string, item types.AppInstanceStatus) error {
+	return p.pub.Publish(key, item)
+}
+
+// Unpublish an item
+func (p AppInstanceStatusPublication) Unpublish(key string) error {
+	return p.pub.Unpublish(key)
+}
+
+// SignalRestarted signal the publisher has started one more time
+func (p AppInstanceStatusPublication) SignalRestarted() error {
+	return p.pub.SignalRestarted()
+}
+
+// ClearRestarted clear the restarted flag
+func (p AppInstanceStatusPublication) ClearRestarted() error {
+	return p.pub.ClearRestarted()
+}
+
+// Get returns a copy of the item and whether it was found
+func (p AppInstanceStatusPublication) Get(key string) (types.AppInstanceStatus, bool) {
+	item, err := p.pub.Get(key)
+	if err != nil {
+		return types.AppInstanceStatus{}, false
+	}
+	return item.(types.AppInstanceStatus), true
+}
+
+// GetAll returns a copy of all the items
+func (p AppInstanceStatusPublication) GetAll() map[string]types.AppInstanceStatus {
+	result := make(map[string]types.AppInstanceStatus)
+	for key, item := range p.pub.GetAll() {
+		result[key] = item.(types.AppInstanceStatus)
+	}
+	return result
+}
+
+// Iterate calls function for each item until it returns false
+func (p AppInstanceStatusPublication) Iterate(function func(key string, item types.AppInstanceStatus) bool) {
+	p.pub.Iterate(func(key string, item interface{}) bool {
+		return function(key, item.(types.AppInstanceStatus))
+	})
+}
+
+// Close the publication
+func (p AppInstanceStatusPublication) Close() error {
+	return p.pub.Close()
+}
+
+// AppInstanceStatusSubscriptionOptions are the options for a AppInstanceStatusSubscription
+type AppInstanceStatusSubscriptionOptions struct {
+	CreateHandler  func(ctx interface{}, key string, item types.AppInstanceStatus)
+	ModifyHandler  func(ctx interface{}, key string, item types.AppInstanceStatus, oldItem types.AppInstanceStatus)
+	DeleteHandler  func(ctx interface{}, key string, item types.AppInstanceStatus)
+	RestartHandler pubsub.SubRestartHandler
+	SyncHandler    pubsub.SubSyncHandler
+	WarningTime    time.Duration
+	ErrorTime      time.Duration
+	AgentName      string
+	AgentScope     string
+	Activate       bool
+	Ctx            interface{}
+	Persistent     bool
+	MyAgentName    string // For logging
+	CoalesceWindow time.Duration
+	MaxQueueLength int
+	QueuePolicy    pubsub.QueuePolicy
+}
+
+// AppInstanceStatusSubscription is a typed pubsub.Subscription for types.AppInstanceStatus
+type AppInstanceStatusSubscription struct {
+	sub pubsub.Subscription
+}
+
+// NewAppInstanceStatusSubscription creates a subscription for types.AppInstanceStatus
+func NewAppInstanceStatusSubscription(ps *pubsub.PubSub, options AppInstanceStatusSubscriptionOptions) (AppInstanceStatusSubscription, error) {
+	subOptions := pubsub.SubscriptionOptions{
+		RestartHandler: options.RestartHandler,
+		SyncHandler:    options.SyncHandler,
+		WarningTime:    options.WarningTime,
+		ErrorTime:      options.ErrorTime,
+		AgentName:      options.AgentName,
+		AgentScope:     options.AgentScope,
+		TopicImpl:      types.AppInstanceStatus{},
+		Activate:       options.Activate,
+		Ctx:            options.Ctx,
+		Persistent:     options.Persistent,
+		MyAgentName:    options.MyAgentName,
+		CoalesceWindow: options.CoalesceWindow,
+		MaxQueueLength: options.MaxQueueLength,
+		QueuePolicy:    options.QueuePolicy,
+	}
+	if options.CreateHandler != nil {
+		handler := options.CreateHandler
+		subOptions.CreateHandler = func(ctx interface{}, key string, item interface{}) {
+			handler(ctx, key, item.(types.AppInstanceStatus))
+		}
+	}
+	if options.ModifyHandler != nil {
+		handler := options.ModifyHandler
+		subOptions.ModifyHandler = func(ctx interface{}, key string, item interface{}, oldItem interface{}) {
+			handler(ctx, key, item.(types.AppInstanceStatus), oldItem.(types.AppInstanceStatus))
+		}
+	}
+	if options.DeleteHandler != nil {
+		handler := options.DeleteHandler
+		subOptions.DeleteHandler = func(ctx interface{}, key string, item interface{}) {
+			handler(ctx, key, item.(types.AppInstanceStatus))
+		}
+	}
+	sub, err := ps.NewSubscription(subOptions)
+	return AppInstanceStatusSubscription{sub: sub}, err
+}
+
+// Untyped returns the underlying pubsub.Subscription
+func (s AppInstanceStatusSubscription) Untyped() pubsub.Subscription {
+	return s.sub
+}
+
+// Get returns the item and whether it was found
+func (s AppInstanceStatusSubscription) Get(key string) (types.AppInstanceStatus, bool) {
+	item, err := s.sub.Get(key)
+	if err != nil {
+		return types.AppInstanceStatus{}, false
+	}
+	return item.(types.AppInstanceStatus), true
+}
+
+// GetAll returns all the items
+func (s AppInstanceStatusSubscription) GetAll() map[string]types.AppInstanceStatus {
+	result := make(map[string]types.AppInstanceStatus)
+	for key, item := range s.sub.GetAll() {
+		result[key] = item.(types.AppInstanceStatus)
+	}
+	return result
+}
+
+// Iterate calls function for each item until it returns false
+func (s AppInstanceStatusSubscription) Iterate(function func(key string, item types.AppInstanceStatus) bool) {
+	s.sub.Iterate(func(key string, item interface{}) bool {
+		return function(key, item.(types.AppInstanceStatus))
+	})
+}
+
+// Restarted reports if the publisher has restarted
+func (s AppInstanceStatusSubscription) Restarted() bool {
+	return s.sub.Restarted()
+}
+
+// RestartCounter reports how many times the publisher has restarted
+func (s AppInstanceStatusSubscription) RestartCounter() int {
+	return s.sub.RestartCounter()
+}
+
+// Synchronized reports if the initial items have been received
+func (s AppInstanceStatusSubscription) Synchronized() bool {
+	return s.sub.Synchronized()
+}
+
+// ProcessChange processes a change from MsgChan
+func (s AppInstanceStatusSubscription) ProcessChange(change pubsub.Change) {
+	s.sub.ProcessChange(change)
+}
+
+// MsgChan returns the channel of changes
+func (s AppInstanceStatusSubscription) MsgChan() <-chan pubsub.Change {
+	return s.sub.MsgChan()
+}
+
+// Activate starts the subscription
+func (s AppInstanceStatusSubscription) Activate() error {
+	return s.sub.Activate()
+}
+
+// Close stops the subscription
+func (s AppInstanceStatusSubscription) Close() error {
+	return s.sub.Close()
+}
+
+// DomainConfigPublication is a typed pubsub.Publication for types.DomainConfig
+type DomainConfigPublication struct {
+	pub pubsub.Publication
+}
+
+// NewDomainConfigPublication creates a publication for types.DomainConfig
+func NewDomainConfigPublication(ps *pubsub.PubSub, options PublicationOptions) (DomainConfigPublication, error) {
+	pub, err := ps.NewPublication(pubsub.PublicationOptions{
+		AgentName:  options.AgentName,
+		AgentScope: options.AgentScope,
+		TopicType:  types.DomainConfig{},
+		Persistent: options.Persistent,
+	})
+	return DomainConfigPublication{pub: pub}, err
+}
+
+// Untyped returns the underlying pubsub.Publication
+func (p DomainConfigPublication) Untyped() pubsub.Publication {
+	return p.pub
+}
+
+// CheckMaxSize returns an error if the item is too large
+func (p DomainConfigPublication) CheckMaxSize(key string, item types.DomainConfig) error {
+	return p.pub.CheckMaxSize(key, item)
+}
+
+// Publish an item
+func (p DomainConfigPublication) Publish(key string, item types.DomainConfig) error {
+	return p.pub.Publish(key, item)
+}
+
+// Unpublish an item
+func (p DomainConfigPublication) Unpublish(key string) error {
+	return p.pub.Unpublish(key)
+}
+
+// SignalRestarted signal the publisher has started one more time
+func (p DomainConfigPublication) SignalRestarted() error {
+	return p.pub.SignalRestarted()
+}
+
+// ClearRestarted clear the restarted flag
+func (p DomainConfigPublication) ClearRestarted() error {
+	return p.pub.ClearRestarted()
+}
+
+// Get returns a copy of the item and whether it was found
+func (p DomainConfigPublication) Get(key string) (types.DomainConfig, bool) {
+	item, err := p.pub.Get(key)
+	if err != nil {
+		return types.DomainConfig{}, false
+	}
+	return item.(types.DomainConfig), true
+}
+
+// GetAll returns a copy of all the items
+func (p DomainConfigPublication) GetAll() map[string]types.DomainConfig {
+	result := make(map[string]types.DomainConfig)
+	for key, item := range p.pub.GetAll() {
+		result[key] = item.(types.DomainConfig)
+	}
+	return result
+}
+
+// Iterate calls function for each item until it returns false
+func (p DomainConfigPublication) Iterate(function func(key string, item types.DomainConfig) bool) {
+	p.pub.Iterate(func(key string, item interface{}) bool {
+		return function(key, item.(types.DomainConfig))
+	})
+}
+
+// Close the publication
+func (p DomainConfigPublication) Close() error {
+	return p.pub.Close()
+}
+
+// DomainConfigSubscriptionOptions are the options for a DomainConfigSubscription
+type DomainConfigSubscriptionOptions struct {
+	CreateHandler  func(ctx interface{}, key string, item types.DomainConfig)
+	ModifyHandler  func(ctx interface{}, key string, item types.DomainConfig, oldItem types.DomainConfig)
+	DeleteHandler  func(ctx interface{}, key string, item types.DomainConfig)
+	RestartHandler pubsub.SubRestartHandler
+	SyncHandler    pubsub.SubSyncHandler
+	WarningTime    time.Duration
+	ErrorTime      time.Duration
+	AgentName      string
+	AgentScope     string
+	Activate       bool
+	Ctx            interface{}
+	Persistent     bool
+	MyAgentName    string // For logging
+	CoalesceWindow time.Duration
+	MaxQueueLength int
+	QueuePolicy    pubsub.QueuePolicy
+}
+
+// DomainConfigSubscription is a typed pubsub.Subscription for types.DomainConfig
+type DomainConfigSubscription struct {
+	sub pubsub.Subscription
+}
+
+// NewDomainConfigSubscription creates a subscription for types.DomainConfig
+func NewDomainConfigSubscription(ps *pubsub.PubSub, options DomainConfigSubscriptionOptions) (DomainConfigSubscription, error) {
+	subOptions := pubsub.SubscriptionOptions{
+		RestartHandler: options.RestartHandler,
+		SyncHandler:    options.SyncHandler,
+		WarningTime:    options.WarningTime,
+		ErrorTime:      options.ErrorTime,
+		AgentName:      options.AgentName,
+		AgentScope:     options.AgentScope,
+		TopicImpl:      types.DomainConfig{},
+		Activate:       options.Activate,
+		Ctx:            options.Ctx,
+		Persistent:     options.Persistent,
+		MyAgentName:    options.MyAgentName,
+		CoalesceWindow: options.CoalesceWindow,
+		MaxQueueLength: options.MaxQueueLength,
+		QueuePolicy:    options.QueuePolicy,
+	}
+	if options.CreateHandler != nil {
+		handler := options.CreateHandler
+		subOptions.CreateHandler = func(ctx interface{}, key string, item interface{}) {
+			handler(ctx, key, item.(types.DomainConfig))
+		}
+	}
+	if options.ModifyHandler != nil {
+		handler := options.ModifyHandler
+		subOptions.ModifyHandler = func(ctx interface{}, key string, item interface{}, oldItem interface{}) {
+			handler(ctx, key, item.(types.DomainConfig), oldItem.(types.DomainConfig))
+		}
+	}
+	if options.DeleteHandler != nil {
+		handler := options.DeleteHandler
+		subOptions.DeleteHandler = func(ctx interface{}, key string, item interface{}) {
+			handler(ctx, key, item.(types.DomainConfig))
+		}
+	}
+	sub, err := ps.NewSubscription(subOptions)
+	return DomainConfigSubscription{sub: sub}, err
+}
+
+// Untyped returns the underlying pubsub.Subscription
+func (s DomainConfigSubscription) Untyped() pubsub.Subscription {
+	return s.sub
+}
+
+// Get returns the item and whether it was found
+func (s DomainConfigSubscription) Get(key string) (types.DomainConfig, bool) {
+	item, err := s.sub.Get(key)
+	if err != nil {
+		return types.DomainConfig{}, false
+	}
+	return item.(types.DomainConfig), true
+}
+
+// GetAll returns all the items
+func (s DomainConfigSubscription) GetAll() map[string]types.DomainConfig {
+	result := make(map[string]types.DomainConfig)
+	for key, item := range s.sub.GetAll() {
+		result[key] = item.(types.DomainConfig)
+	}
+	return result
+}
+
+// Iterate calls function for each item until it returns false
+func (s DomainConfigSubscription) Iterate(function func(key string, item types.DomainConfig) bool) {
+	s.sub.Iterate(func(key string, item interface{}) bool {
+		return function(key, item.(types.DomainConfig))
+	})
+}
+
+// Restarted reports if the publisher has restarted
+func (s DomainConfigSubscription) Restarted() bool {
+	return s.sub.Restarted()
+}
+
+// RestartCounter reports how many times the publisher has restarted
+func (s DomainConfigSubscription) RestartCounter() int {
+	return s.sub.RestartCounter()
+}
+
+// Synchronized reports if the initial items have been received
+func (s DomainConfigSubscription) Synchronized() bool {
+	return s.sub.Synchronized()
+}
+
+// ProcessChange processes a change from MsgChan
+func (s DomainConfigSubscription) ProcessChange(change pubsub.Change) {
+	s.sub.ProcessChange(change)
+}
+
+// MsgChan returns the channel of changes
+func (s DomainConfigSubscription) MsgChan() <-chan pubsub.Change {
+	return s.sub.MsgChan()
+}
+
+// Activate starts the subscription
+func (s DomainConfigSubscription) Activate() error {
+	return s.sub.Activate()
+}
+
+// Close stops the subscription
+func (s DomainConfigSubscription) Close() error {
+	return s.sub.Close()
+}
+
+// DomainStatusPublication is a typed pubsub.Publication for types.DomainStatus
+type DomainStatusPublication struct {
+	pub pubsub.Publication
+}
+
+// NewDomainStatusPublication creates a publication for types.DomainStatus
+func NewDomainStatusPublication(ps *pubsub.PubSub, options PublicationOptions) (DomainStatusPublication, error) {
+	pub, err := ps.NewPublication(pubsub.PublicationOptions{
+		AgentName:  options.AgentName,
+		AgentScope: options.AgentScope,
+		TopicType:  types.DomainStatus{},
+		Persistent: options.Persistent,
+	})
+	return DomainStatusPublication{pub: pub}, err
+}
+
+// Untyped returns the underlying pubsub.Publication
+func (p DomainStatusPublication) Untyped() pubsub.Publication {
+	return p.pub
+}
+
+// CheckMaxSize returns an error if the item is too large
+func (p DomainStatusPublication) CheckMaxSize(key string, item types.DomainStatus) error {
+	return p.pub.CheckMaxSize(key, item)
+}
+
+// Publish an item
+func (p DomainStatusPublication) Publish(key string, item types.DomainStatus) error {
+	return p.pub.Publish(key, item)
+}
+
+// Unpublish an item
+func (p DomainStatusPublication) Unpublish(key string) error {
+	return p.pub.Unpublish(key)
+}
+
+// SignalRestarted signal the publisher has started one more time
+func (p DomainStatusPublication) SignalRestarted() error {
+	return p.pub.SignalRestarted()
+}
+
+// ClearRestarted clear the restarted flag
+func (p DomainStatusPublication) ClearRestarted() error {
+	return p.pub.ClearRestarted()
+}
+
+// Get returns a copy of the item and whether it was found
+func (p DomainStatusPublication) Get(key string) (types.DomainStatus, bool) {
+	item, err := p.pub.Get(key)
+	if err != nil {
+		return types.DomainStatus{}, false
+	}
+	return item.(types.DomainStatus), true
+}
+
+// GetAll returns a copy of all the items
+func (p DomainStatusPublication) GetAll() map[string]types.DomainStatus {
+	result := make(map[string]types.DomainStatus)
+	for key, item := range p.pub.GetAll() {
+		result[key] = item.(types.DomainStatus)
+	}
+	return result
+}
+
+// Iterate calls function for each item until it returns false
+func (p DomainStatusPublication) Iterate(function func(key string, item types.DomainStatus) bool) {
+	p.pub.Iterate(func(key string, item interface{}) bool {
+		return function(key, item.(types.DomainStatus))
+	})
+}
+
+// Close the publication
+func (p DomainStatusPublication) Close() error {
+	return p.pub.Close()
+}
+
+// DomainStatusSubscriptionOptions are the options for a DomainStatusSubscription
+type DomainStatusSubscriptionOptions struct {
+	CreateHandler  func(ctx interface{}, key string, item types.DomainStatus)
+	ModifyHandler  func(ctx interface{}, key string, item types.DomainStatus, oldItem types.DomainStatus)
+	DeleteHandler  func(ctx interface{}, key string, item types.DomainStatus)
+	RestartHandler pubsub.SubRestartHandler
+	SyncHandler    pubsub.SubSyncHandler
+	WarningTime    time.Duration
+	ErrorTime      time.Duration
+	AgentName      string
+	AgentScope     string
+	Activate       bool
+	Ctx            interface{}
+	Persistent     bool
+	MyAgentName    string // For logging
+	CoalesceWindow time.Duration
+	MaxQueueLength int
+	QueuePolicy    pubsub.QueuePolicy
+}
+
+// DomainStatusSubscription is a typed pubsub.Subscription for types.DomainStatus
+type DomainStatusSubscription struct {
+	sub pubsub.Subscription
+}
+
+// NewDomainStatusSubscription creates a subscription for types.DomainStatus
+func NewDomainStatusSubscription(ps *pubsub.PubSub, options DomainStatusSubscriptionOptions) (DomainStatusSubscription, error) {
+	subOptions := pubsub.SubscriptionOptions{
+		RestartHandler: options.RestartHandler,
+		SyncHandler:    options.SyncHandler,
+		WarningTime:    options.WarningTime,
+		ErrorTime:      options.ErrorTime,
+		AgentName:      options.AgentName,
+		AgentScope:     options.AgentScope,
+		TopicImpl:      types.DomainStatus{},
+		Activate:       options.Activate,
+		Ctx:            options.Ctx,
+		Persistent:     options.Persistent,
+		MyAgentName:    options.MyAgentName,
+		CoalesceWindow: options.CoalesceWindow,
+		MaxQueueLength: options.MaxQueueLength,
+		QueuePolicy:    options.QueuePolicy,
+	}
+	if options.CreateHandler != nil {
+		handler := options.CreateHandler
+		subOptions.CreateHandler = func(ctx interface{}, key string, item interface{}) {
+			handler(ctx, key, item.(types.DomainStatus))
+		}
+	}
+	if options.ModifyHandler != nil {
+		handler := options.ModifyHandler
+		subOptions.ModifyHandler = func(ctx interface{}, key string, item interface{}, oldItem interface{}) {
+			handler(ctx, key, item.(types.DomainStatus), oldItem.(types.DomainStatus))
+		}
+	}
+	if options.DeleteHandler != nil {
+		handler := options.DeleteHandler
+		subOptions.DeleteHandler = func(ctx interface{}, key string, item interface{}) {
+			handler(ctx, key, item.(types.DomainStatus))
+		}
+	}
+	sub, err := ps.NewSubscription(subOptions)
+	return DomainStatusSubscription{sub: sub}, err
+}
+
+// Untyped returns the underlying pubsub.Subscription
+func (s DomainStatusSubscription) Untyped() pubsub.Subscription {
+	return s.sub
+}
+
+// Get returns the item and whether it was found
+func (s DomainStatusSubscription) Get(key string) (types.DomainStatus, bool) {
+	item, err := s.sub.Get(key)
+	if err != nil {
+		return types.DomainStatus{}, false
+	}
+	return item.(types.DomainStatus), true
+}
+
+// GetAll returns all the items
+func (s DomainStatusSubscription) GetAll() map[string]types.DomainStatus {
+	result := make(map[string]types.DomainStatus)
+	for key, item := range s.sub.GetAll() {
+		result[key] = item.(types.DomainStatus)
+	}
+	return result
+}
+
+// Iterate calls function for each item until it returns false
+func (s DomainStatusSubscription) Iterate(function func(key string, item types.DomainStatus) bool) {
+	s.sub.Iterate(func(key string, item interface{}) bool {
+		return function(key, item.(types.DomainStatus))
+	})
+}
+
+// Restarted reports if the publisher has restarted
+func (s DomainStatusSubscription) Restarted() bool {
+	return s.sub.Restarted()
+}
+
+// RestartCounter reports how many times the publisher has restarted
+func (s DomainStatusSubscription) RestartCounter() int {
+	return s.sub.RestartCounter()
+}
+
+// Synchronized reports if the initial items have been received
+func (s DomainStatusSubscription) Synchronized() bool {
+	return s.sub.Synchronized()
+}
+
+// ProcessChange processes a change from MsgChan
+func (s DomainStatusSubscription) ProcessChange(change pubsub.Change) {
+	s.sub.ProcessChange(change)
+}
+
+// MsgChan returns the channel of changes
+func (s DomainStatusSubscription) MsgChan() <-chan pubsub.Change {
+	return s.sub.MsgChan()
+}
+
+// Activate starts the subscription
+func (s DomainStatusSubscription) Activate() error {
+	return s.sub.Activate()
+}
+
+// Close stops the subscription
+func (s DomainStatusSubscription) Close() error {
+	return s.sub.Close()
+}
+
+// DomainMetricPublication is a typed pubsub.Publication for types.DomainMetric
+type DomainMetricPublication struct {
+	pub pubsub.Publication
+}
+
+// NewDomainMetricPublication creates a publication for types.DomainMetric
+func NewDomainMetricPublication(ps *pubsub.PubSub, options PublicationOptions) (DomainMetricPublication, error) {
+	pub, err := ps.NewPublication(pubsub.PublicationOptions{
+		AgentName:  options.AgentName,
+		AgentScope: options.AgentScope,
+		TopicType:  types.DomainMetric{},
+		Persistent: options.Persistent,
+	})
+	return DomainMetricPublication{pub: pub}, err
+}
+
+// Untyped returns the underlying pubsub.Publication
+func (p DomainMetricPublication) Untyped() pubsub.Publication {
+	return p.pub
+}
+
+// CheckMaxSize returns an error if the item is too large
+func (p DomainMetricPublication) CheckMaxSize(key string, item types.DomainMetric) error {
+	return p.pub.CheckMaxSize(key, item)
+}
+
+// Publish an item
+func (p DomainMetricPublication) Publish(key string, item types.DomainMetric) error {
+	return p.pub.Publish(key, item)
+}
+
+// Unpublish an item
+func (p DomainMetricPublication) Unpublish(key string) error {
+	return p.pub.Unpublish(key)
+}
+
+// SignalRestarted signal the publisher has started one more time
+func (p DomainMetricPublication) SignalRestarted() error {
+	return p.pub.SignalRestarted()
+}
+
+// ClearRestarted clear the restarted flag
+func (p DomainMetricPublication) ClearRestarted() error {
+	return p.pub.ClearRestarted()
+}
+
+// Get returns a copy of the item and whether it was found
+func (p DomainMetricPublication) Get(key string) (types.DomainMetric, bool) {
+	item, err := p.pub.Get(key)
+	if err != nil {
+		return types.DomainMetric{}, false
+	}
+	return item.(types.DomainMetric), true
+}
+
+// GetAll returns a copy of all the items
+func (p DomainMetricPublication) GetAll() map[string]types.DomainMetric {
+	result := make(map[string]types.DomainMetric)
+	for key, item := range p.pub.GetAll() {
+		result[key] = item.(types.DomainMetric)
+	}
+	return result
+}
+
+// Iterate calls function for each item until it returns false
+func (p DomainMetricPublication) Iterate(function func(key string, item types.DomainMetric) bool) {
+	p.pub.Iterate(func(key string, item interface{}) bool {
+		return function(key, item.(types.DomainMetric))
+	})
+}
+
+// Close the publication
+func (p DomainMetricPublication) Close() error {
+	return p.pub.Close()
+}
+
+// DomainMetricSubscriptionOptions are the options for a DomainMetricSubscription
+type DomainMetricSubscriptionOptions struct {
+	CreateHandler  func(ctx interface{}, key string, item types.DomainMetric)
+	ModifyHandler  func(ctx interface{}, key string, item types.DomainMetric, oldItem types.DomainMetric)
+	DeleteHandler  func(ctx interface{}, key string, item types.DomainMetric)
+	RestartHandler pubsub.SubRestartHandler
+	SyncHandler    pubsub.SubSyncHandler
+	WarningTime    time.Duration
+	ErrorTime      time.Duration
+	AgentName      string
+	AgentScope     string
+	Activate       bool
+	Ctx            interface{}
+	Persistent     bool
+	MyAgentName    string // For logging
+	CoalesceWindow time.Duration
+	MaxQueueLength int
+	QueuePolicy    pubsub.QueuePolicy
+}
+
+// DomainMetricSubscription is a typed pubsub.Subscription for types.DomainMetric
+type DomainMetricSubscription struct {
+	sub pubsub.Subscription
+}
+
+// NewDomainMetricSubscription creates a subscription for types.DomainMetric
+func NewDomainMetricSubscription(ps *pubsub.PubSub, options DomainMetricSubscriptionOptions) (DomainMetricSubscription, error) {
+	subOptions := pubsub.SubscriptionOptions{
+		RestartHandler: options.RestartHandler,
+		SyncHandler:    options.SyncHandler,
+		WarningTime:    options.WarningTime,
+		ErrorTime:      options.ErrorTime,
+		AgentName:      options.AgentName,
+		AgentScope:     options.AgentScope,
+		TopicImpl:      types.DomainMetric{},
+		Activate:       options.Activate,
+		Ctx:            options.Ctx,
+		Persistent:     options.Persistent,
+		MyAgentName:    options.MyAgentName,
+		CoalesceWindow: options.CoalesceWindow,
+		MaxQueueLength: options.MaxQueueLength,
+		QueuePolicy:    options.QueuePolicy,
+	}
+	if options.CreateHandler != nil {
+		handler := options.CreateHandler
+		subOptions.CreateHandler = func(ctx interface{}, key string, item interface{}) {
+			handler(ctx, key, item.(types.DomainMetric))
+		}
+	}
+	if options.ModifyHandler != nil {
+		handler := options.ModifyHandler
+		subOptions.ModifyHandler = func(ctx interface{}, key string, item interface{}, oldItem interface{}) {
+			handler(ctx, key, item.(types.DomainMetric), oldItem.(types.DomainMetric))
+		}
+	}
+	if options.DeleteHandler != nil {
+		handler := options.DeleteHandler
+		subOptions.DeleteHandler = func(ctx interface{}, key string, item interface{}) {
+			handler(ctx, key, item.(types.DomainMetric))
+		}
+	}
+	sub, err := ps.NewSubscription(subOptions)
+	return DomainMetricSubscription{sub: sub}, err
+}
+
+// Untyped returns the underlying pubsub.Subscription
+func (s DomainMetricSubscription) Untyped() pubsub.Subscription {
+	return s.sub
+}
+
+// Get returns the item and whether it was found
+func (s DomainMetricSubscription) Get(key string) (types.DomainMetric, bool) {
+	item, err := s.sub.Get(key)
+	if err != nil {
+		return types.DomainMetric{}, false
+	}
+	return item.(types.DomainMetric), true
+}
+
+// GetAll returns all the items
+func (s DomainMetricSubscription) GetAll() map[string]types.DomainMetric {
+	result := make(map[string]types.DomainMetric)
+	for key, item := range s.sub.GetAll() {
+		result[key] = item.(types.DomainMetric)
+	}
+	return result
+}
+
+// Iterate calls function for each item until it returns false
+func (s DomainMetricSubscription) Iterate(function func(key string, item types.DomainMetric) bool) {
+	s.sub.Iterate(func(key string, item interface{}) bool {
+		return function(key, item.(types.DomainMetric))
+	})
+}
+
+// Restarted reports if the publisher has restarted
+func (s DomainMetricSubscription) Restarted() bool {
+	return s.sub.Restarted()
+}
+
+// RestartCounter reports how many times the publisher has restarted
+func (s DomainMetricSubscription) RestartCounter() int {
+	return s.sub.RestartCounter()
+}
+
+// Synchronized reports if the initial items have been received
+func (s DomainMetricSubscription) Synchronized() bool {
+	return s.sub.Synchronized()
+}
+
+// ProcessChange processes a change from MsgChan
+func (s DomainMetricSubscription) ProcessChange(change pubsub.Change) {
+	s.sub.ProcessChange(change)
+}
+
+// MsgChan returns the channel of changes
+func (s DomainMetricSubscription) MsgChan() <-chan pubsub.Change {
+	return s.sub.MsgChan()
+}
+
+// Activate starts the subscription
+func (s DomainMetricSubscription) Activate() error {
+	return s.sub.Activate()
+}
+
+// Close stops the subscription
+func (s DomainMetricSubscription) Close() error {
+	return s.sub.Close()
+}
+
+// VolumeConfigPublication is a typed pubsub.Publication for types.VolumeConfig
+type VolumeConfigPublication struct {
+	pub pubsub.Publication
+}
+
+// NewVolumeConfigPublication creates a publication for types.VolumeConfig
+func NewVolumeConfigPublication(ps *pubsub.PubSub, options PublicationOptions) (VolumeConfigPublication, error) {
+	pub, err := ps.NewPublication(pubsub.PublicationOptions{
+		AgentName:  options.AgentName,
+		AgentScope: options.AgentScope,
+		TopicType:  types.VolumeConfig{},
+		Persistent: options.Persistent,
+	})
+	return VolumeConfigPublication{pub: pub}, err
+}
+
+// Untyped returns the underlying pubsub.Publication
+func (p VolumeConfigPublication) Untyped() pubsub.Publication {
+	return p.pub
+}
+
+// CheckMaxSize returns an error if the item is too large
+func (p VolumeConfigPublication) CheckMaxSize(key string, item types.VolumeConfig) error {
+	return p.pub.CheckMaxSize(key, item)
+}
+
+// Publish an item
+func (p VolumeConfigPublication) Publish(key string, item types.VolumeConfig) error {
+	return p.pub.Publish(key, item)
+}
+
+// Unpublish an item
+func (p VolumeConfigPublication) Unpublish(key string) error {
+	return p.pub.Unpublish(key)
+}
+
+// SignalRestarted signal the publisher has started one more time
+func (p VolumeConfigPublication) SignalRestarted() error {
+	return p.pub.SignalRestarted()
+}
+
+// ClearRestarted clear the restarted flag
+func (p VolumeConfigPublication) ClearRestarted() error {
+	return p.pub.ClearRestarted()
+}
+
+// Get returns a copy of the item and whether it was found
+func (p VolumeConfigPublication) Get(key string) (types.VolumeConfig, bool) {
+	item, err := p.pub.Get(key)
+	if err != nil {
+		return types.VolumeConfig{}, false
+	}
+	return item.(types.VolumeConfig), true
+}
+
+// GetAll returns a copy of all the items
+func (p VolumeConfigPublication) GetAll() map[string]types.VolumeConfig {
+	result := make(map[string]types.VolumeConfig)
+	for key, item := range p.pub.GetAll() {
+		result[key] = item.(types.VolumeConfig)
+	}
+	return result
+}
+
+// Iterate calls function for each item until it returns false
+func (p VolumeConfigPublication) Iterate(function func(key string, item types.VolumeConfig) bool) {
+	p.pub.Iterate(func(key string, item interface{}) bool {
+		return function(key, item.(types.VolumeConfig))
+	})
+}
+
+// Close the publication
+func (p VolumeConfigPublication) Close() error {
+	return p.pub.Close()
+}
+
+// VolumeConfigSubscriptionOptions are the options for a VolumeConfigSubscription
+type VolumeConfigSubscriptionOptions struct {
+	CreateHandler  func(ctx interface{}, key string, item types.VolumeConfig)
+	ModifyHandler  func(ctx interface{}, key string, item types.VolumeConfig, oldItem types.VolumeConfig)
+	DeleteHandler  func(ctx interface{}, key string, item types.VolumeConfig)
+	RestartHandler pubsub.SubRestartHandler
+	SyncHandler    pubsub.SubSyncHandler
+	WarningTime    time.Duration
+	ErrorTime      time.Duration
+	AgentName      string
+	AgentScope     string
+	Activate       bool
+	Ctx            interface{}
+	Persistent     bool
+	MyAgentName    string // For logging
+	CoalesceWindow time.Duration
+	MaxQueueLength int
+	QueuePolicy    pubsub.QueuePolicy
+}
+
+// VolumeConfigSubscription is a typed pubsub.Subscription for types.VolumeConfig
+type VolumeConfigSubscription struct {
+	sub pubsub.Subscription
+}
+
+// NewVolumeConfigSubscription creates a subscription for types.VolumeConfig
+func NewVolumeConfigSubscription(ps *pubsub.PubSub, options VolumeConfigSubscriptionOptions) (VolumeConfigSubscription, error) {
+	subOptions := pubsub.SubscriptionOptions{
+		RestartHandler: options.RestartHandler,
+		SyncHandler:    options.SyncHandler,
+		WarningTime:    options.WarningTime,
+		ErrorTime:      options.ErrorTime,
+		AgentName:      options.AgentName,
+		AgentScope:     options.AgentScope,
+		TopicImpl:      types.VolumeConfig{},
+		Activate:       options.Activate,
+		Ctx:            options.Ctx,
+		Persistent:     options.Persistent,
+		MyAgentName:    options.MyAgentName,
+		CoalesceWindow: options.CoalesceWindow,
+		MaxQueueLength: options.MaxQueueLength,
+		QueuePolicy:    options.QueuePolicy,
+	}
+	if options.CreateHandler != nil {
+		handler := options.CreateHandler
+		subOptions.CreateHandler = func(ctx interface{}, key string, item interface{}) {
+			handler(ctx, key, item.(types.VolumeConfig))
+		}
+	}
+	if options.ModifyHandler != nil {
+		handler := options.ModifyHandler
+		subOptions.ModifyHandler = func(ctx interface{}, key string, item interface{}, oldItem interface{}) {
+			handler(ctx, key, item.(types.VolumeConfig), oldItem.(types.VolumeConfig))
+		}
+	}
+	if options.DeleteHandler != nil {
+		handler := options.DeleteHandler
+		subOptions.DeleteHandler = func(ctx interface{}, key string, item interface{}) {
+			handler(ctx, key, item.(types.VolumeConfig))
+		}
+	}
+	sub, err := ps.NewSubscription(subOptions)
+	return VolumeConfigSubscription{sub: sub}, err
+}
+
+// Untyped returns the underlying pubsub.Subscription
+func (s VolumeConfigSubscription) Untyped() pubsub.Subscription {
+	return s.sub
+}
+
+// Get returns the item and whether it was found
+func (s VolumeConfigSubscription) Get(key string) (types.VolumeConfig, bool) {
+	item, err := s.sub.Get(key)
+	if err != nil {
+		return types.VolumeConfig{}, false
+	}
+	return item.(types.VolumeConfig), true
+}
+
+// GetAll returns all the items
+func (s VolumeConfigSubscription) GetAll() map[string]types.VolumeConfig {
+	result := make(map[string]types.VolumeConfig)
+	for key, item := range s.sub.GetAll() {
+		result[key] = item.(types.VolumeConfig)
+	}
+	return result
+}
+
+// Iterate calls function for each item until it returns false
+func (s VolumeConfigSubscription) Iterate(function func(key string, item types.VolumeConfig) bool) {
+	s.sub.Iterate(func(key string, item interface{}) bool {
+		return function(key, item.(types.VolumeConfig))
+	})
+}
+
+// Restarted reports if the publisher has restarted
+func (s VolumeConfigSubscription) Restarted() bool {
+	return s.sub.Restarted()
+}
+
+// RestartCounter reports how many times the publisher has restarted
+func (s VolumeConfigSubscription) RestartCounter() int {
+	return s.sub.RestartCounter()
+}
+
+// Synchronized reports if the initial items have been received
+func (s VolumeConfigSubscription) Synchronized() bool {
+	return s.sub.Synchronized()
+}
+
+// ProcessChange processes a change from MsgChan
+func (s VolumeConfigSubscription) ProcessChange(change pubsub.Change) {
+	s.sub.ProcessChange(change)
+}
+
+// MsgChan returns the channel of changes
+func (s VolumeConfigSubscription) MsgChan() <-chan pubsub.Change {
+	return s.sub.MsgChan()
+}
+
+// Activate starts the subscription
+func (s VolumeConfigSubscription) Activate() error {
+	return s.sub.Activate()
+}
+
+// Close stops the subscription
+func (s VolumeConfigSubscription) Close() error {
+	return s.sub.Close()
+}
+
+// VolumeStatusPublication is a typed pubsub.Publication for types.VolumeStatus
+type VolumeStatusPublication struct {
+	pub pubsub.Publication
+}
+
+// NewVolumeStatusPublication creates a publication for types.VolumeStatus
+func NewVolumeStatusPublication(ps *pubsub.PubSub, options PublicationOptions) (VolumeStatusPublication, error) {
+	pub, err := ps.NewPublication(pubsub.PublicationOptions{
+		AgentName:  options.AgentName,
+		AgentScope: options.AgentScope,
+		TopicType:  types.VolumeStatus{},
+		Persistent: options.Persistent,
+	})
+	return VolumeStatusPublication{pub: pub}, err
+}
+
+// Untyped returns the underlying pubsub.Publication
+func (p VolumeStatusPublication) Untyped() pubsub.Publication {
+	return p.pub
+}
+
+// CheckMaxSize returns an error if the item is too large
+func (p VolumeStatusPublication) CheckMaxSize(key string, item types.VolumeStatus) error {
+	return p.pub.CheckMaxSize(key, item)
+}
+
+// Publish an item
+func (p VolumeStatusPublication) Publish(key string, item types.VolumeStatus) error {
+	return p.pub.Publish(key, item)
+}
+
+// Unpublish an item
+func (p VolumeStatusPublication) Unpublish(key string) error {
+	return p.pub.Unpublish(key)
+}
+
+// SignalRestarted signal the publisher has started one more time
+func (p VolumeStatusPublication) SignalRestarted() error {
+	return p.pub.SignalRestarted()
+}
+
+// ClearRestarted clear the restarted flag
+func (p VolumeStatusPublication) ClearRestarted() error {
+	return p.pub.ClearRestarted()
+}
+
+// Get returns a copy of the item and whether it was found
+func (p VolumeStatusPublication) Get(key string) (types.VolumeStatus, bool) {
+	item, err := p.pub.Get(key)
+	if err != nil {
+		return types.VolumeStatus{}, false
+	}
+	return item.(types.VolumeStatus), true
+}
+
+// GetAll returns a copy of all the items
+func (p VolumeStatusPublication) GetAll() map[string]types.VolumeStatus {
+	result := make(map[string]types.VolumeStatus)
+	for key, item := range p.pub.GetAll() {
+		result[key] = item.(types.VolumeStatus)
+	}
+	return result
+}
+
+// Iterate calls function for each item until it returns false
+func (p VolumeStatusPublication) Iterate(function func(key string, item types.VolumeStatus) bool) {
+	p.pub.Iterate(func(key string, item interface{}) bool {
+		return function(key, item.(types.VolumeStatus))
+	})
+}
+
+// Close the publication
+func (p VolumeStatusPublication) Close() error {
+	return p.pub.Close()
+}
+
+// VolumeStatusSubscriptionOptions are the options for a VolumeStatusSubscription
+type VolumeStatusSubscriptionOptions struct {
+	CreateHandler  func(ctx interface{}, key string, item types.VolumeStatus)
+	ModifyHandler  func(ctx interface{}, key string, item types.VolumeStatus, oldItem types.VolumeStatus)
+	DeleteHandler  func(ctx interface{}, key string, item types.VolumeStatus)
+	RestartHandler pubsub.SubRestartHandler
+	SyncHandler    pubsub.SubSyncHandler
+	WarningTime    time.Duration
+	ErrorTime      time.Duration
+	AgentName      string
+	AgentScope     string
+	Activate       bool
+	Ctx            interface{}
+	Persistent     bool
+	MyAgentName    string // For logging
+	CoalesceWindow time.Duration
+	MaxQueueLength int
+	QueuePolicy    pubsub.QueuePolicy
+}
+
+// VolumeStatusSubscription is a typed pubsub.Subscription for types.VolumeStatus
+type VolumeStatusSubscription struct {
+	sub pubsub.Subscription
+}
+
+// NewVolumeStatusSubscription creates a subscription for types.VolumeStatus
+func NewVolumeStatusSubscription(ps *pubsub.PubSub, options VolumeStatusSubscriptionOptions) (VolumeStatusSubscription, error) {
+	subOptions := pubsub.SubscriptionOptions{
+		RestartHandler: options.RestartHandler,
+		SyncHandler:    options.SyncHandler,
+		WarningTime:    options.WarningTime,
+		ErrorTime:      options.ErrorTime,
+		AgentName:      options.AgentName,
+		AgentScope:     options.AgentScope,
+		TopicImpl:      types.VolumeStatus{},
+		Activate:       options.Activate,
+		Ctx:            options.Ctx,
+		Persistent:     options.Persistent,
+		MyAgentName:    options.MyAgentName,
+		CoalesceWindow: options.CoalesceWindow,
+		MaxQueueLength: options.MaxQueueLength,
+		QueuePolicy:    options.QueuePolicy,
+	}
+	if options.CreateHandler != nil {
+		handler := options.CreateHandler
+		subOptions.CreateHandler = func(ctx interface{}, key string, item interface{}) {
+			handler(ctx, key, item.(types.VolumeStatus))
+		}
+	}
+	if options.ModifyHandler != nil {
+		handler := options.ModifyHandler
+		subOptions.ModifyHandler = func(ctx interface{}, key string, item interface{}, oldItem interface{}) {
+			handler(ctx, key, item.(types.VolumeStatus), oldItem.(types.VolumeStatus))
+		}
+	}
+	if options.DeleteHandler != nil {
+		handler := options.DeleteHandler
+		subOptions.DeleteHandler = func(ctx interface{}, key string, item interface{}) {
+			handler(ctx, key, item.(types.VolumeStatus))
+		}
+	}
+	sub, err := ps.NewSubscription(subOptions)
+	return VolumeStatusSubscription{sub: sub}, err
+}
+
+// Untyped returns the underlying pubsub.Subscription
+func (s VolumeStatusSubscription) Untyped() pubsub.Subscription {
+	return s.sub
+}
+
+// Get returns the item and whether it was found
+func (s VolumeStatusSubscription) Get(key string) (types.VolumeStatus, bool) {
+	item, err := s.sub.Get(key)
+	if err != nil {
+		return types.VolumeStatus{}, false
+	}
+	return item.(types.VolumeStatus), true
+}
+
+// GetAll returns all the items
+func (s VolumeStatusSubscription) GetAll() map[string]types.VolumeStatus {
+	result := make(map[string]types.VolumeStatus)
+	for key, item := range s.sub.GetAll() {
+		result[key] = item.(types.VolumeStatus)
+	}
+	return result
+}
+
+// Iterate calls function for each item until it returns false
+func (s VolumeStatusSubscription) Iterate(function func(key string, item types.VolumeStatus) bool) {
+	s.sub.Iterate(func(key string, item interface{}) bool {
+		return function(key, item.(types.VolumeStatus))
+	})
+}
+
+// Restarted reports if the publisher has restarted
+func (s VolumeStatusSubscription) Restarted() bool {
+	return s.sub.Restarted()
+}
+
+// RestartCounter reports how many times the publisher has restarted
+func (s VolumeStatusSubscription) RestartCounter() int {
+	return s.sub.RestartCounter()
+}
+
+// Synchronized reports if the initial items have been received
+func (s VolumeStatusSubscription) Synchronized() bool {
+	return s.sub.Synchronized()
+}
+
+// ProcessChange processes a change from MsgChan
+func (s VolumeStatusSubscription) ProcessChange(change pubsub.Change) {
+	s.sub.ProcessChange(change)
+}
+
+// MsgChan returns the channel of changes
+func (s VolumeStatusSubscription) MsgChan() <-chan pubsub.Change {
+	return s.sub.MsgChan()
+}
+
+// Activate starts the subscription
+func (s VolumeStatusSubscription) Activate() error {
+	return s.sub.Activate()
+}
+
+// Close stops the subscription
+func (s VolumeStatusSubscription) Close() error {
+	return s.sub.Close()
+}
@@ -66,6 +66,10 @@ type IoBundle struct {
 	Ioports string // E.g., "2f8-2ff"
 	Serial  string // E.g., "/dev/ttyS1"
 	UsbAddr string // E.g., "1:2.3"
+	// USB devices can also be assigned by rule in which case UsbAddr is
+	// filled in locally while a matching device is plugged in
+	UsbProduct string // Vendor:product, e.g., "0bda:8153"
+	UsbSerial  string // Optional serial number to match as well
 
 	// Attributes Derived and assigned locally ( not from controller)
 
@@ -117,11 +121,22 @@ func (ib IoBundle) HasAdapterChanged(log *base.LogObject, phyAdapter PhysicalIOA
 			ib.Serial, phyAdapter.Phyaddr.Serial)
 		return true
 	}
-	if phyAdapter.Phyaddr.UsbAddr != ib.UsbAddr {
+	// the UsbAddr of a USB device assigned by rule is ours to fill in
+	if phyAdapter.Phyaddr.UsbProduct == "" && phyAdapter.Phyaddr.UsbAddr != ib.UsbAddr {
 		log.Functionf("USB address changed from %s to %s",
 			ib.UsbAddr, phyAdapter.Phyaddr.UsbAddr)
 		return true
 	}
+	if !strings.EqualFold(phyAdapter.Phyaddr.UsbProduct, ib.UsbProduct) {
+		log.Functionf("USB product changed from %s to %s",
+			ib.UsbProduct, phyAdapter.Phyaddr.UsbProduct)
+		return true
+	}
+	if phyAdapter.Phyaddr.UsbSerial != ib.UsbSerial {
+		log.Functionf("USB serial changed from %s to %s",
+			ib.UsbSerial, phyAdapter.Phyaddr.UsbSerial)
+		return true
+	}
 	if phyAdapter.Phyaddr.Irq != ib.Irq {
 		log.Functionf("Irq changed from %s to %s", ib.Irq, phyAdapter.Phyaddr.Irq)
 		return true
@@ -159,6 +174,8 @@ func IoBundleFromPhyAdapter(log *base.LogObject, phyAdapter PhysicalIOAdapter) *
 	ib.Ifname = phyAdapter.Phyaddr.Ifname
 	ib.PciLong = phyAdapter.Phyaddr.PciLong
 	ib.UsbAddr = phyAdapter.Phyaddr.UsbAddr
+	ib.UsbProduct = phyAdapter.Phyaddr.UsbProduct
+	ib.UsbSerial = phyAdapter.Phyaddr.UsbSerial
 	ib.Irq = phyAdapter.Phyaddr.Irq
 	ib.Ioports = phyAdapter.Phyaddr.Ioports
 	ib.Serial = phyAdapter.Phyaddr.Serial
@@ -176,6 +193,15 @@ func IoBundleFromPhyAdapter(log *base.LogObject, phyAdapter PhysicalIOAdapter) *
 	return &ib
 }
 
+// MatchesUsbDevice checks if the USB device with the given vendor:product
+// and serial number is assigned by the rule of the bundle, if any
+func (ib IoBundle) MatchesUsbDevice(product string, serial string) bool {
+	if ib.UsbProduct == "" || !strings.EqualFold(ib.UsbProduct, product) {
+		return false
+	}
+	return ib.UsbSerial == "" || ib.UsbSerial == serial
+}
+
 // Must match definition of PhyIoType in devmodel.proto which is an strict
 // subset of the values in ZCioType in devmodel.proto
 type IoType uint8
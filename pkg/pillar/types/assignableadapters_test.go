@@ -149,11 +149,13 @@ func TestIoBundleFromPhyAdapter(t *testing.T) {
 		Logicallabel: "shopfloor",
 		Assigngrp:    "eth-grp-1",
 		Phyaddr: PhysicalAddress{
-			Ifname:  "eth0",
-			PciLong: "0000:04:00.0",
-			Irq:     "5",
-			Ioports: "3f8-3ff",
-			Serial:  "/dev/ttyS0",
+			Ifname:     "eth0",
+			PciLong:    "0000:04:00.0",
+			Irq:        "5",
+			Ioports:    "3f8-3ff",
+			Serial:     "/dev/ttyS0",
+			UsbProduct: "0bda:8153",
+			UsbSerial:  "000001",
 		},
 		Usage: zcommon.PhyIoMemberUsage_PhyIoUsageMgmtAndApps,
 		UsagePolicy: PhyIOUsagePolicy{
@@ -172,6 +174,8 @@ func TestIoBundleFromPhyAdapter(t *testing.T) {
 	assert.Equal(t, phyAdapter.Phyaddr.Irq, ibPtr.Irq)
 	assert.Equal(t, phyAdapter.Phyaddr.Ioports, ibPtr.Ioports)
 	assert.Equal(t, phyAdapter.Phyaddr.Serial, ibPtr.Serial)
+	assert.Equal(t, phyAdapter.Phyaddr.UsbProduct, ibPtr.UsbProduct)
+	assert.Equal(t, phyAdapter.Phyaddr.UsbSerial, ibPtr.UsbSerial)
 	assert.Equal(t, phyAdapter.Usage, ibPtr.Usage)
 }
 
@@ -452,3 +456,26 @@ func TestExpandControllers(t *testing.T) {
 		}
 	}
 }
+
+func TestMatchesUsbDevice(t *testing.T) {
+	testMatrix := map[string]struct {
+		ib       IoBundle
+		product  string
+		serial   string
+		expected bool
+	}{
+		"no rule":         {ib: IoBundle{UsbAddr: "1:2"}, product: "0bda:8153", expected: false},
+		"product":         {ib: IoBundle{UsbProduct: "0bda:8153"}, product: "0bda:8153", serial: "123", expected: true},
+		"product case":    {ib: IoBundle{UsbProduct: "0BDA:8153"}, product: "0bda:8153", expected: true},
+		"other product":   {ib: IoBundle{UsbProduct: "0bda:8153"}, product: "0bda:8152", expected: false},
+		"serial":          {ib: IoBundle{UsbProduct: "0bda:8153", UsbSerial: "123"}, product: "0bda:8153", serial: "123", expected: true},
+		"other serial":    {ib: IoBundle{UsbProduct: "0bda:8153", UsbSerial: "123"}, product: "0bda:8153", serial: "456", expected: false},
+		"no serial given": {ib: IoBundle{UsbProduct: "0bda:8153", UsbSerial: "123"}, product: "0bda:8153", expected: false},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		if match := test.ib.MatchesUsbDevice(test.product, test.serial); match != test.expected {
+			t.Errorf("%s: got %t expected %t", testname, match, test.expected)
+		}
+	}
+}
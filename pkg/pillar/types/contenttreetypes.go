@@ -5,6 +5,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	zconfig "github.com/lf-edge/eve/api/go/config"
@@ -23,6 +24,8 @@ type ContentTreeConfig struct {
 	MaxDownloadSize   uint64
 	GenerationCounter int64
 	DisplayName       string
+	// Pinned content is never evicted to make room on /persist
+	Pinned bool
 }
 
 // Key is content info UUID which will be unique
@@ -115,6 +118,10 @@ type ContentTreeStatus struct {
 	Progress          uint   // In percent i.e., 0-100
 	FileLocation      string // Location of filestystem
 	NameIsURL         bool
+	Pinned            bool
+	// LastUse is when a volume was last created from the content tree or
+	// else when it was created
+	LastUse time.Time
 	// Blobs the sha256 hashes of the blobs that are in this tree, the first of which always is the root
 	Blobs []string
 
@@ -231,3 +238,28 @@ func (status ContentTreeStatus) LogDelete(logBase *base.LogObject) {
 func (status ContentTreeStatus) LogKey() string {
 	return string(base.ContentTreeStatusLogType) + "-" + status.Key()
 }
+
+// ContentEviction is a content tree volumemgr deleted before
+// DeferContentDelete was up to make room on /persist
+type ContentEviction struct {
+	ContentID   uuid.UUID
+	DisplayName string
+	RelativeURL string
+	Size        int64     // Bytes we expected to reclaim
+	LastUse     time.Time // When a volume was last created from the content tree
+	EvictedAt   time.Time
+	Reason      string
+}
+
+// ContentEvictionStatus is published by volumemgr under the key "global"
+type ContentEvictionStatus struct {
+	Evictions    uint64 // Since volumemgr started
+	EvictedBytes uint64
+	// Recent are the last evictions, oldest first
+	Recent []ContentEviction
+}
+
+// Key returns the pubsub Key
+func (status ContentEvictionStatus) Key() string {
+	return "global"
+}
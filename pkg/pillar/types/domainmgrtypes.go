@@ -6,6 +6,7 @@ package types
 import (
 	"fmt"
 	uuid "github.com/satori/go.uuid"
+	"io"
 	"os"
 	"strconv"
 	"strings"
@@ -39,6 +40,160 @@ type DomainConfig struct {
 
 	// CipherBlockStatus, for encrypted cloud-init data
 	CipherBlockStatus
+
+	// SnapshotCmd is applied to a running domain when its Counter changes
+	SnapshotCmd DomainSnapshotCmd
+
+	// RestartPolicy says whether domainmgr boots the domain again after
+	// it stopped on its own
+	RestartPolicy RestartPolicy
+
+	// SecurityProfile hardens the task of a container domain
+	SecurityProfile SecurityProfile
+}
+
+// Values of SecurityProfile.Seccomp other than a JSON profile
+const (
+	SeccompUnconfined = "unconfined"
+	SeccompDefault    = "default"
+	// SeccompCustom is only reported in EffectiveSecurityProfile
+	SeccompCustom = "custom"
+)
+
+// SecurityProfile is the hardening of the containerd task of a container
+// app on top of the defaults of containerd. The zero value changes nothing.
+type SecurityProfile struct {
+	// Seccomp is empty or SeccompUnconfined for none, SeccompDefault for
+	// the EVE default, or an OCI runtime spec LinuxSeccomp in JSON
+	Seccomp  string
+	AppArmor string   // Name of a loaded AppArmor profile
+	CapAdd   []string // Like CAP_NET_ADMIN
+	CapDrop  []string // ALL drops all the default ones; CapAdd still applies
+	// ReadOnlyRootfs mounts the root filesystem of the container read-only
+	ReadOnlyRootfs  bool
+	NoNewPrivileges bool
+	// UserNamespace maps root in the container to an unprivileged range of
+	// host ids. Rejected until the rootfs snapshot is remapped to that range.
+	UserNamespace bool
+}
+
+// EffectiveSecurityProfile is what was applied to the task of a domain
+type EffectiveSecurityProfile struct {
+	Seccomp         string   // SeccompUnconfined, SeccompDefault or SeccompCustom
+	AppArmor        string   // Empty if none
+	Capabilities    []string // The bounding set
+	ReadOnlyRootfs  bool
+	NoNewPrivileges bool
+	UIDMapHostID    uint32 // Host uid of root in the container; 0 if not remapped
+}
+
+// RestartPolicy is what to do when a running domain stops without being
+// asked to. Failed boots are retried regardless.
+// The values match AppRestartPolicy in the API.
+type RestartPolicy uint8
+
+const (
+	// RestartPolicyDefault means the app.restart.policy global setting
+	// applies; domainmgr treats it as RestartPolicyNever
+	RestartPolicyDefault RestartPolicy = iota
+	// RestartPolicyNever leaves the domain halted
+	RestartPolicyNever
+	// RestartPolicyOnFailure restarts the domain if it crashed
+	RestartPolicyOnFailure
+	// RestartPolicyAlways restarts the domain even if it halted cleanly
+	RestartPolicyAlways
+)
+
+// String returns the name of the policy as used in the global settings
+func (policy RestartPolicy) String() string {
+	switch policy {
+	case RestartPolicyDefault:
+		return "default"
+	case RestartPolicyNever:
+		return "never"
+	case RestartPolicyOnFailure:
+		return "on-failure"
+	case RestartPolicyAlways:
+		return "always"
+	default:
+		return fmt.Sprintf("Unknown RestartPolicy %d", policy)
+	}
+}
+
+// ParseRestartPolicy is the inverse of String for the policies which can
+// be used in the global settings
+func ParseRestartPolicy(s string) (RestartPolicy, error) {
+	for _, policy := range []RestartPolicy{RestartPolicyNever,
+		RestartPolicyOnFailure, RestartPolicyAlways} {
+		if s == policy.String() {
+			return policy, nil
+		}
+	}
+	return RestartPolicyNever, fmt.Errorf("unknown restart policy %s", s)
+}
+
+// SnapshotAction is the operation requested by a DomainSnapshotCmd
+type SnapshotAction uint8
+
+const (
+	// SnapshotActionNone means no operation
+	SnapshotActionNone SnapshotAction = iota
+	// SnapshotActionSave saves the state of the domain under Name
+	SnapshotActionSave
+	// SnapshotActionRestore rolls the domain back to the state saved under Name
+	SnapshotActionRestore
+)
+
+// String returns the name of the action
+func (action SnapshotAction) String() string {
+	switch action {
+	case SnapshotActionNone:
+		return "none"
+	case SnapshotActionSave:
+		return "save"
+	case SnapshotActionRestore:
+		return "restore"
+	default:
+		return fmt.Sprintf("Unknown SnapshotAction %d", action)
+	}
+}
+
+// DomainSnapshotCmd is similar to AppInstanceOpsCmd: the Action is performed
+// once each time the Counter is changed
+type DomainSnapshotCmd struct {
+	Counter uint32
+	Action  SnapshotAction
+	Name    string // Letters, digits, '.', '_' and '-' only
+}
+
+// snapshotNameValid is what we allow in a snapshot name since it ends up
+// in monitor commands and image references
+func snapshotNameValid(name string) bool {
+	if name == "" || len(name) > 64 {
+		return false
+	}
+	for _, c := range name {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		case c == '.', c == '_', c == '-':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// Validate returns an error if the command can not be applied
+func (cmd DomainSnapshotCmd) Validate() error {
+	switch cmd.Action {
+	case SnapshotActionSave, SnapshotActionRestore:
+	default:
+		return fmt.Errorf("invalid snapshot action %s", cmd.Action)
+	}
+	if !snapshotNameValid(cmd.Name) {
+		return fmt.Errorf("invalid snapshot name %q", cmd.Name)
+	}
+	return nil
 }
 
 // GetOCIConfigDir returns a location for OCI Config
@@ -92,6 +247,20 @@ func (config DomainConfig) VirtualizationModeOrDefault() VmMode {
 	}
 }
 
+// Ballooning returns true if the memory of the domain can be varied between
+// Memory, which is what is guaranteed and accounted for, and MaxMem
+func (config DomainConfig) Ballooning() bool {
+	return config.MaxMem > config.Memory
+}
+
+// MaxMemOrDefault returns the most memory the domain can get in kbytes
+func (config DomainConfig) MaxMemOrDefault() int {
+	if config.Ballooning() {
+		return config.MaxMem
+	}
+	return config.Memory
+}
+
 // LogCreate :
 func (config DomainConfig) LogCreate(logBase *base.LogObject) {
 	logObject := base.NewLogObject(logBase, base.DomainConfigLogType, config.DisplayName,
@@ -172,6 +341,18 @@ type VmConfig struct {
 	EnableVnc          bool
 	VncDisplay         uint32
 	VncPasswd          string
+	// Attach a qemu-guest-agent channel; only for kvm
+	EnableGuestAgent bool
+	// Give the domain a TPM 2.0 emulated by swtpm; only for kvm
+	EnableVTPM bool
+	// cgroup controls for the containerd task of the domain; zero means
+	// the default
+	CPUShares   uint64 // relative CPU weight; default 1024
+	CPUQuota    int    // in percent of one CPU; default 100 * VCpus
+	MemoryHigh  int    // in kbytes; reclaimed down to this under pressure
+	MemoryMax   int    // in kbytes; default Memory
+	BlkioWeight uint16 // 10 to 1000; default 500
+	PidsLimit   int64  // default no limit
 }
 
 type VmMode uint8
@@ -193,6 +374,95 @@ type Task interface {
 	Stop(string, int, bool) error
 	Delete(string, int) error
 	Info(string, int) (int, SwState, error)
+	// Snapshot saves the state of a running domain under a name and
+	// Restore rolls the domain back to a state saved that way
+	Snapshot(string, int, string) error
+	Restore(string, int, string) error
+	// GuestInfo returns nil if there is no guest agent for the domain
+	GuestInfo(string, int) (*GuestInfo, error)
+	// SecurityProfile returns nil if the domain is not a container
+	SecurityProfile(string) (*EffectiveSecurityProfile, error)
+	// PodContainers returns the status of the additional containers
+	// which run next to the main one; nil if the domain is not a pod
+	PodContainers(string) ([]PodContainerStatus, error)
+	// Console attaches to the serial console of a running domain; nil if
+	// the domain has none (its output goes to its logs anyway). Also
+	// returns true if the output of the console goes to the logs of the
+	// domain without us.
+	Console(string, int) (io.ReadWriteCloser, bool, error)
+	// SetMemory balloons a running domain to the given kbytes
+	SetMemory(string, int, int) error
+	// AttachDisk/DetachDisk and AttachVif/DetachVif hot-plug devices
+	// into and out of a running domain
+	AttachDisk(string, int, DiskStatus) error
+	DetachDisk(string, int, DiskStatus) error
+	AttachVif(string, int, VifInfo) error
+	DetachVif(string, int, VifInfo) error
+	// AttachUsb/DetachUsb pass the USB device of the host at a UsbAddr
+	// through to a running domain and take it back
+	AttachUsb(string, int, string) error
+	DetachUsb(string, int, string) error
+}
+
+// GuestInfo is what the guest agent of a domain reports
+type GuestInfo struct {
+	Responsive    bool      // Did the agent answer the last ping
+	LastSeen      time.Time // When the agent last answered
+	OSName        string
+	KernelRelease string
+	IPAddrs       []string // As seen by the guest; excludes loopback
+}
+
+// DomainEventType is the kind of event a hypervisor reports for a domain
+type DomainEventType uint8
+
+const (
+	// DomainEventUnknown is not reported by any hypervisor
+	DomainEventUnknown DomainEventType = iota
+	// DomainEventShutdown means the guest or the host shut the domain down
+	DomainEventShutdown
+	// DomainEventReset means the domain was reset, e.g., guest initiated reboot
+	DomainEventReset
+	// DomainEventBlockIOError means a disk of the domain failed an I/O request
+	DomainEventBlockIOError
+	// DomainEventGuestPanicked means the guest kernel panicked
+	DomainEventGuestPanicked
+	// DomainEventWatchdog means the watchdog of the domain fired
+	DomainEventWatchdog
+	// DomainEventResume means the domain runs again after being paused,
+	// e.g., after a DomainEventBlockIOError
+	DomainEventResume
+)
+
+// String returns the name of the event type
+func (t DomainEventType) String() string {
+	switch t {
+	case DomainEventUnknown:
+		return "unknown"
+	case DomainEventShutdown:
+		return "shutdown"
+	case DomainEventReset:
+		return "reset"
+	case DomainEventBlockIOError:
+		return "block-io-error"
+	case DomainEventGuestPanicked:
+		return "guest-panicked"
+	case DomainEventWatchdog:
+		return "watchdog"
+	case DomainEventResume:
+		return "resume"
+	default:
+		return fmt.Sprintf("Unknown DomainEventType %d", t)
+	}
+}
+
+// DomainEvent is sent by a hypervisor as soon as something happens to a
+// domain so that domainmgr doesn't have to wait for the next Info() poll
+type DomainEvent struct {
+	DomainName string // Task name of the domain
+	Type       DomainEventType
+	Time       time.Time
+	Details    string // Hypervisor specific, e.g., JSON data of a QMP event
 }
 
 type DomainStatus struct {
@@ -215,12 +485,91 @@ type DomainStatus struct {
 	VncDisplay         uint32
 	VncPasswd          string
 	TriedCount         int
-	// ErrorAndTime provides SetErrorNow() and ClearError()
-	ErrorAndTime
+	// ErrorAndTimeWithSource provides SetErrorWithSource() etc
+	ErrorAndTimeWithSource
 	BootFailed     bool
 	AdaptersFailed bool
 	OCIConfigDir   string            // folder holding an OCI Image config for this domain (empty string means no config)
 	EnvVariables   map[string]string // List of environment variables to be set in container
+	// SnapshotCounter is the Counter of the last DomainSnapshotCmd applied
+	SnapshotCounter uint32
+	// Snapshots are the names of the snapshots saved for the current
+	// volumes; the ones of a container only last as long as its task
+	Snapshots []string
+	// EnableGuestAgent is set when the domain was activated with a guest agent channel
+	EnableGuestAgent bool
+	GuestInfo        GuestInfo
+	// SecurityProfile is set once the task of a container domain is set up
+	SecurityProfile *EffectiveSecurityProfile
+	// VTPMStateDir is where the swtpm of the domain keeps its state; empty
+	// if the domain has no TPM
+	VTPMStateDir string
+	// CPUs the domain is pinned to in cpulist format; empty if not pinned
+	CPUs          string
+	CPUsDedicated bool // CPUs are not shared with other apps
+	NUMANode      int  // Of all the CPUs; -1 if not pinned or they span nodes
+	// RestartPending is set while we wait for NextRestartTime to boot the
+	// domain again after it stopped on its own
+	RestartPending  bool
+	NextRestartTime time.Time // Also when maybeRetryBoot tries again
+	RestartCount    uint32    // Times we booted the domain again after it stopped
+	// RecentExits counts the exits and failed boots since the domain last
+	// ran for a while; the restart backoff grows with it
+	RecentExits    uint32
+	CrashLoop      bool // RecentExits reached the crash loop threshold
+	LastExitReason string
+	LastExitTime   time.Time
+	// PodContainers is the status of the additional containers of a pod
+	PodContainers []PodContainerStatus
+	// ConsolePort is the port on localhost where one can attach to the
+	// serial console of the domain; 0 if not captured
+	ConsolePort int
+}
+
+// PodContainerStatus is the status of one of the additional containers of
+// a pod. They share the network namespace of the main container and the
+// volumes of the app instance.
+type PodContainerStatus struct {
+	Name     string
+	State    SwState
+	PID      int
+	ExitCode uint32 // Once HALTED
+}
+
+// IsPod returns true if the domain runs additional containers
+func (status DomainStatus) IsPod() bool {
+	for _, ds := range status.DiskStatusList {
+		if ds.PodContainer != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// PodContainerTaskName returns the name of the containerd task of one of
+// the additional containers of the pod of the domain with domainName
+func PodContainerTaskName(domainName string, container string) string {
+	return domainName + "." + container
+}
+
+// PodContainerFromTaskName does the reverse of PodContainerTaskName.
+// Returns the domain name and an empty container name for other tasks.
+func PodContainerFromTaskName(name string) (string, string) {
+	res := strings.SplitN(name, ".", 4)
+	if len(res) != 4 {
+		return name, ""
+	}
+	return strings.Join(res[:3], "."), res[3]
+}
+
+// HasSnapshot returns true if a snapshot with that name was saved
+func (status DomainStatus) HasSnapshot(name string) bool {
+	for _, s := range status.Snapshots {
+		if s == name {
+			return true
+		}
+	}
+	return false
 }
 
 func (status DomainStatus) Key() string {
@@ -278,7 +627,7 @@ func (status DomainStatus) LogModify(logBase *base.LogObject, old interface{}) {
 	}
 
 	if status.HasError() {
-		errAndTime := status.ErrorAndTime
+		errAndTime := status.ErrorAndTime()
 		logObject.CloneAndAddField("state", status.State.String()).
 			AddField("activated", status.Activated).
 			AddField("error", errAndTime.Error).
@@ -308,6 +657,8 @@ type VifInfo struct {
 	Vif     string
 	VifUsed string // Has -emu in name in Status if appropriate
 	Mac     string
+	// Hotplugged is set in Status if attached to the running domain
+	Hotplugged bool
 }
 
 // DomainManager will pass these to the xen xl config file
@@ -322,6 +673,9 @@ type DiskConfig struct {
 	Format       zconfig.Format
 	MountDir     string
 	DisplayName  string
+	// PodContainer names the additional container of the pod which runs
+	// the OCI image of the volume; empty for the main container and data
+	PodContainer string
 }
 
 type DiskStatus struct {
@@ -333,6 +687,8 @@ type DiskStatus struct {
 	DisplayName  string
 	Devtype      string // XXX used internally by hypervisor; deprecate?
 	Vdev         string // Allocated
+	Hotplugged   bool   // Attached to the running domain
+	PodContainer string // From DiskConfig
 }
 
 // DomainMetric carries CPU and memory usage. UUID=devUUID for the dom0/host metrics overhead
@@ -342,6 +698,7 @@ type DomainMetric struct {
 	UsedMemory        uint32
 	AvailableMemory   uint32
 	UsedMemoryPercent float64
+	AllocatedMB       uint32 // Currently given to the domain; changes with ballooning
 }
 
 // Key returns the key for pubsub
@@ -167,6 +167,10 @@ const (
 	// Dom0DiskUsageMaxBytes - Max disk usage for Dom0. Dom0 can use
 	//  Dom0MinDiskUsagePercent upto a max of  Dom0DiskUsageMaxBytes
 	Dom0DiskUsageMaxBytes GlobalSettingKey = "storage.dom0.disk.maxusagebytes"
+	// ContentEvictHighWatermark global setting key
+	ContentEvictHighWatermark GlobalSettingKey = "storage.content.evict.high.percent"
+	// ContentEvictLowWatermark global setting key
+	ContentEvictLowWatermark GlobalSettingKey = "storage.content.evict.low.percent"
 	// AppContainerStatsInterval - App Container Stats Collection
 	AppContainerStatsInterval GlobalSettingKey = "timer.appcontainer.stats.interval"
 	// VaultReadyCutOffTime global setting key
@@ -181,12 +185,18 @@ const (
 	// how the EVE microservices will use free and non-free (e.g., WWAN)
 	// ports for image downloads.
 	DownloadMaxPortCost GlobalSettingKey = "network.download.max.cost"
+	// DownloadParallelRanges global setting key
+	DownloadParallelRanges GlobalSettingKey = "network.download.parallel.ranges"
 
 	// Bool Items
 	// UsbAccess global setting key
 	UsbAccess GlobalSettingKey = "debug.enable.usb"
 	// AllowAppVnc global setting key
 	AllowAppVnc GlobalSettingKey = "app.allow.vnc"
+	// PinAppCPUs global setting key
+	PinAppCPUs GlobalSettingKey = "app.pin.cpus"
+	// AppRestartPolicy global setting key
+	AppRestartPolicy GlobalSettingKey = "app.restart.policy"
 	// EveMemoryLimitInBytes global setting key
 	EveMemoryLimitInBytes GlobalSettingKey = "memory.eve.limit.bytes"
 	// IgnoreMemoryCheckForApps global setting key
@@ -750,6 +760,8 @@ func NewConfigItemSpecMap() ConfigItemSpecMap {
 	configItemSpecMap.AddIntItem(NetworkTestTimeout, 15, 0, 0xFFFFFFFF)
 	configItemSpecMap.AddIntItem(NetworkSendTimeout, 120, 0, 0xFFFFFFFF)
 	configItemSpecMap.AddIntItem(Dom0MinDiskUsagePercent, 20, 20, 80)
+	configItemSpecMap.AddIntItem(ContentEvictHighWatermark, 90, 10, 100)
+	configItemSpecMap.AddIntItem(ContentEvictLowWatermark, 80, 10, 100)
 	configItemSpecMap.AddIntItem(AppContainerStatsInterval, 300, 1, 0xFFFFFFFF)
 	configItemSpecMap.AddIntItem(VaultReadyCutOffTime, 300, 60, 0xFFFFFFFF)
 	// Dom0DiskUsageMaxBytes - Default is 2GB, min is 100MB
@@ -761,10 +773,12 @@ func NewConfigItemSpecMap() ConfigItemSpecMap {
 	// LogRemainToSendMBytes - Default is 2 Gbytes, minimum is 10 Mbytes
 	configItemSpecMap.AddIntItem(LogRemainToSendMBytes, 2048, 10, 0xFFFFFFFF)
 	configItemSpecMap.AddIntItem(DownloadMaxPortCost, 0, 0, 255)
+	configItemSpecMap.AddIntItem(DownloadParallelRanges, 1, 1, 16)
 
 	// Add Bool Items
 	configItemSpecMap.AddBoolItem(UsbAccess, true) // Controller likely default to false
 	configItemSpecMap.AddBoolItem(AllowAppVnc, false)
+	configItemSpecMap.AddBoolItem(PinAppCPUs, false)
 	configItemSpecMap.AddBoolItem(IgnoreMemoryCheckForApps, false)
 	configItemSpecMap.AddBoolItem(IgnoreDiskCheckForApps, false)
 	configItemSpecMap.AddBoolItem(AllowLogFastupload, false)
@@ -778,6 +792,7 @@ func NewConfigItemSpecMap() ConfigItemSpecMap {
 	configItemSpecMap.AddStringItem(SSHAuthorizedKeys, "", blankValidator)
 	configItemSpecMap.AddStringItem(DefaultLogLevel, "info", parseLevel)
 	configItemSpecMap.AddStringItem(DefaultRemoteLogLevel, "info", parseLevel)
+	configItemSpecMap.AddStringItem(AppRestartPolicy, RestartPolicyNever.String(), parseRestartPolicy)
 
 	// Add Agent Settings
 	configItemSpecMap.AddAgentSettingStringItem(LogLevel, "info", parseLevel)
@@ -792,6 +807,12 @@ func parseLevel(level string) error {
 	return err
 }
 
+// parseRestartPolicy - Wrapper that ignores the policy output of ParseRestartPolicy
+func parseRestartPolicy(policy string) error {
+	_, err := ParseRestartPolicy(policy)
+	return err
+}
+
 // blankValidator - A validator that accepts any string
 func blankValidator(s string) error {
 	return nil
@@ -166,15 +166,19 @@ func TestNewConfigItemSpecMap(t *testing.T) {
 		NetworkTestTimeout,
 		NetworkSendTimeout,
 		Dom0MinDiskUsagePercent,
+		ContentEvictHighWatermark,
+		ContentEvictLowWatermark,
 		AppContainerStatsInterval,
 		VaultReadyCutOffTime,
 		Dom0DiskUsageMaxBytes,
 		ForceFallbackCounter,
 		LogRemainToSendMBytes,
 		DownloadMaxPortCost,
+		DownloadParallelRanges,
 		// Bool Items
 		UsbAccess,
 		AllowAppVnc,
+		PinAppCPUs,
 		EveMemoryLimitInBytes,
 		IgnoreMemoryCheckForApps,
 		IgnoreDiskCheckForApps,
@@ -186,6 +190,7 @@ func TestNewConfigItemSpecMap(t *testing.T) {
 		SSHAuthorizedKeys,
 		DefaultLogLevel,
 		DefaultRemoteLogLevel,
+		AppRestartPolicy,
 		DisableDHCPAllOnesNetMask,
 	}
 	if len(specMap.GlobalSettings) != len(gsKeys) {
@@ -19,6 +19,9 @@ const (
 	SealedDirName = PersistDir + "/vault"
 	// VolumeEncryptedDirName - sealed directory used to store volumes
 	VolumeEncryptedDirName = SealedDirName + "/volumes"
+	// VTPMStateDirName - sealed directory where the software TPMs of the
+	// domains keep their state
+	VTPMStateDirName = SealedDirName + "/vtpm"
 	// ClearDirName - directory which is not encrypted
 	ClearDirName = PersistDir + "/clear"
 	// VolumeClearDirName - Not encrypted directory used to store volumes
@@ -75,6 +78,8 @@ const (
 	NewlogUploadAppDir = NewlogDir + "/appUpload"
 	// NewlogKeepSentQueueDir - a circular queue of gzip files already been sent
 	NewlogKeepSentQueueDir = NewlogDir + "/keepSentQueue"
+	// PubSubIntrospectSocket - where zedbox serves pubsub introspection
+	PubSubIntrospectSocket = "/run/zedbox/introspect.sock"
 	// EveMemoryLimitFile - stores memory reserved for eve
 	EveMemoryLimitFile = "/hostfs/sys/fs/cgroup/memory/eve/memory.soft_limit_in_bytes"
 )
@@ -21,6 +21,10 @@ type PhysicalAddress struct {
 	Irq     string
 	Ioports string
 	UsbAddr string
+	// UsbProduct (vendor:product) and optionally UsbSerial assign whichever
+	// USB device matches instead of the one at UsbAddr
+	UsbProduct string
+	UsbSerial  string
 	// unknownType - If a type in config is unknown, store it here.
 	UnknownType string
 }
@@ -60,6 +60,8 @@ type AppInstanceConfig struct {
 	IoAdapterList       []IoAdapter
 	RestartCmd          AppInstanceOpsCmd
 	PurgeCmd            AppInstanceOpsCmd
+	SnapshotCmd         DomainSnapshotCmd
+	RestartPolicy       RestartPolicy
 	// XXX: to be deprecated, use CipherBlockStatus instead
 	CloudInitUserData *string `json:"pubsub-large-CloudInitUserData"`
 	RemoteConsole     bool
@@ -68,6 +70,160 @@ type AppInstanceConfig struct {
 
 	// CipherBlockStatus, for encrypted cloud-init data
 	CipherBlockStatus
+
+	// HealthProbes are run by zedmanager while the app is running and
+	// HealthRemediation is applied once one of them keeps failing
+	HealthProbes      []HealthProbe
+	HealthRemediation HealthRemediation
+
+	// Dependencies on other app instances which zedmanager honors when
+	// activating and halting this one
+	Dependencies []AppDependency
+
+	// SecurityProfile of the container for container apps
+	SecurityProfile SecurityProfile
+
+	// PodContainers run the OCI images of some of the volumes next to the
+	// main container of a container app, making it a pod
+	PodContainers []PodContainer
+}
+
+// PodContainer is an additional container of a pod run from the OCI image
+// of one of the volumes of the app instance
+type PodContainer struct {
+	Name     string // Unique in the pod; no dots
+	VolumeID uuid.UUID
+}
+
+// AppDependencyType is how an app instance depends on another one; matches
+// the API
+type AppDependencyType uint8
+
+const (
+	// AppDependencyNone is not a valid dependency
+	AppDependencyNone AppDependencyType = iota
+	// AppDependencyStartAfter activates the app once the other one is RUNNING
+	AppDependencyStartAfter
+	// AppDependencyWaitHealthy activates the app once the other one is HEALTHY
+	AppDependencyWaitHealthy
+	// AppDependencyStopBefore halts the app before the other one is halted
+	// or deleted
+	AppDependencyStopBefore
+)
+
+// String returns the name of the dependency type
+func (depType AppDependencyType) String() string {
+	switch depType {
+	case AppDependencyNone:
+		return "none"
+	case AppDependencyStartAfter:
+		return "start-after"
+	case AppDependencyWaitHealthy:
+		return "wait-until-healthy"
+	case AppDependencyStopBefore:
+		return "stop-before"
+	default:
+		return fmt.Sprintf("Unknown AppDependencyType %d", depType)
+	}
+}
+
+// AppDependency is a dependency on the app instance with AppUUID. It is also
+// used as the ErrorSourceType for dependencies which can't be satisfied.
+type AppDependency struct {
+	AppUUID uuid.UUID
+	Type    AppDependencyType
+}
+
+// HealthProbeType is how a HealthProbe checks the app; matches the API
+type HealthProbeType uint8
+
+const (
+	// HealthProbeNone is not a valid probe
+	HealthProbeNone HealthProbeType = iota
+	// HealthProbeHTTP expects a 2xx or 3xx response to a GET of Path on Port
+	HealthProbeHTTP
+	// HealthProbeTCP expects to be able to connect to Port
+	HealthProbeTCP
+	// HealthProbeExec expects Command to exit with 0 inside a container app
+	HealthProbeExec
+)
+
+// String returns the name of the probe type
+func (probeType HealthProbeType) String() string {
+	switch probeType {
+	case HealthProbeNone:
+		return "none"
+	case HealthProbeHTTP:
+		return "http"
+	case HealthProbeTCP:
+		return "tcp"
+	case HealthProbeExec:
+		return "exec"
+	default:
+		return fmt.Sprintf("Unknown HealthProbeType %d", probeType)
+	}
+}
+
+// HealthProbe checks whether the workload inside an app is healthy. HTTP and
+// TCP probes go to the first IP address allocated to the app.
+type HealthProbe struct {
+	Type             HealthProbeType
+	Port             uint16   // HTTP and TCP
+	Path             string   // HTTP
+	Command          []string // Exec
+	InitialDelaySecs uint32   // After boot before the first check
+	IntervalSecs     uint32
+	TimeoutSecs      uint32
+	FailureThreshold uint32 // Consecutive failures which make the app UNHEALTHY
+}
+
+// HealthRemediation is what zedmanager does with an UNHEALTHY app; matches
+// the API
+type HealthRemediation uint8
+
+const (
+	// HealthRemediationNone only reports the app as UNHEALTHY
+	HealthRemediationNone HealthRemediation = iota
+	// HealthRemediationRestart restarts the app as if a RestartCmd came in
+	HealthRemediationRestart
+)
+
+// String returns the name of the remediation
+func (remediation HealthRemediation) String() string {
+	switch remediation {
+	case HealthRemediationNone:
+		return "none"
+	case HealthRemediationRestart:
+		return "restart"
+	default:
+		return fmt.Sprintf("Unknown HealthRemediation %d", remediation)
+	}
+}
+
+// HealthState is the outcome of the HealthProbes of an app
+type HealthState uint8
+
+const (
+	// HealthUnknown means no probes or none has completed since the app booted
+	HealthUnknown HealthState = iota
+	// HealthHealthy means the last check of every probe passed
+	HealthHealthy
+	// HealthUnhealthy means a probe failed FailureThreshold times in a row
+	HealthUnhealthy
+)
+
+// String returns the name of the state
+func (state HealthState) String() string {
+	switch state {
+	case HealthUnknown:
+		return "UNKNOWN"
+	case HealthHealthy:
+		return "HEALTHY"
+	case HealthUnhealthy:
+		return "UNHEALTHY"
+	default:
+		return fmt.Sprintf("Unknown HealthState %d", state)
+	}
 }
 
 type AppInstanceOpsCmd struct {
@@ -152,6 +308,25 @@ type AppInstanceStatus struct {
 	RestartInprogress   Inprogress
 	PurgeInprogress     Inprogress
 
+	// From DomainStatus; the domain restarts per its RestartPolicy
+	RestartCount   uint32
+	CrashLoop      bool
+	LastExitReason string
+	LastExitTime   time.Time
+
+	// From the HealthProbes in AppInstanceConfig
+	Health           HealthState
+	LastHealthError  string
+	LastHealthCheck  time.Time
+	HealthRemediated uint32 // Times HealthRemediation was applied
+
+	// Set while activating or halting waits for other app instances
+	// per the Dependencies in AppInstanceConfig
+	DependencyWait string
+
+	// From DomainStatus for pods
+	PodContainers []PodContainerStatus
+
 	// Mininum state across all steps and all StorageStatus.
 	// Error* set implies error.
 	State          SwState
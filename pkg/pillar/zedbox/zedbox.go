@@ -10,6 +10,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -54,6 +55,10 @@ const (
 	agentName   = "zedbox"
 	errorTime   = 3 * time.Minute
 	warningTime = 40 * time.Second
+	// If this file exists each agent records its publications to
+	// /persist/agentdebug/<agentname>/pubsub.journal
+	journalEnableFile = types.PersistDebugDir + "/pubsub-journal"
+	journalMaxSize    = 10 * 1024 * 1024
 )
 
 type zedboxInline uint8
@@ -127,6 +132,9 @@ func main() {
 	}
 	// If this zedbox?
 	if basename == agentName {
+		if len(os.Args) > 1 && os.Args[1] == "replay" {
+			os.Exit(runReplay(os.Args[2:]))
+		}
 		sep := entrypoint{f: runZedbox, inline: inlineAlways}
 		logger, log = agentlog.Init(basename)
 		inline := true
@@ -145,6 +153,7 @@ func runService(serviceName string, sep entrypoint, inline bool) int {
 		ps := pubsub.New(
 			&socketdriver.SocketDriver{Logger: logger, Log: log},
 			logger, log)
+		maybeSetJournal(ps, serviceName, log)
 		return sep.f(ps, logger, log)
 	}
 	// Notify zedbox binary to start the agent/service
@@ -183,6 +192,10 @@ func runZedbox(ps *pubsub.PubSub, logger *logrus.Logger, log *base.LogObject) in
 		log.Fatal(err)
 	}
 
+	if err := pubsub.StartIntrospection(log, types.PubSubIntrospectSocket); err != nil {
+		log.Error(err)
+	}
+
 	subChan := reverse.NewSubscriber(log, agentName,
 		types.ServiceInitStatus{})
 	for {
@@ -218,6 +231,7 @@ func handleService(serviceName string, cmdArgs []string) {
 			Log:    srvLog,
 		},
 		srvLogger, srvLog)
+	maybeSetJournal(srvPs, serviceName, srvLog)
 	sep, ok := entrypoints[serviceName]
 	if !ok {
 		log.Fatalf("zedbox: Unknown package: %s",
@@ -231,6 +245,81 @@ func handleService(serviceName string, cmdArgs []string) {
 		serviceName)
 }
 
+// maybeSetJournal enables recording of the publications of the agent
+// if journalEnableFile exists
+func maybeSetJournal(ps *pubsub.PubSub, agentName string, log *base.LogObject) {
+	if _, err := os.Stat(journalEnableFile); err != nil {
+		return
+	}
+	agentDebugDir := fmt.Sprintf("%s/%s", types.PersistDebugDir, agentName)
+	if err := os.MkdirAll(agentDebugDir, 0755); err != nil {
+		log.Errorf("maybeSetJournal: %v", err)
+		return
+	}
+	filename := agentDebugDir + "/pubsub.journal"
+	journal, err := pubsub.NewJournal(log, filename, journalMaxSize)
+	if err != nil {
+		log.Errorf("maybeSetJournal: %v", err)
+		return
+	}
+	log.Noticef("Recording publications to %s", filename)
+	ps.SetJournal(journal)
+}
+
+// runReplay runs an agent with its subscriptions served from pubsub
+// journals instead of the other agents. For example
+// "zedbox replay -a zedmanager /persist/agentdebug/*/pubsub.journal"
+// calls the handlers of zedmanager in the order the changes were recorded.
+// The publications of the agent are recorded to the journal given by -o
+// for comparison with the ones recorded on the device.
+func runReplay(args []string) int {
+	flagSet := flag.NewFlagSet("replay", flag.ExitOnError)
+	agentPtr := flagSet.String("a", "", "Agent name")
+	outputPtr := flagSet.String("o", "", "Journal for the publications")
+	flagSet.Parse(args)
+	serviceName := *agentPtr
+	sep, ok := entrypoints[serviceName]
+	if !ok || flagSet.NArg() == 0 {
+		fmt.Printf("Usage: zedbox replay -a <agent> [-o <journal>] <journal>...\n")
+		return 1
+	}
+	logger, log = agentlog.Init(serviceName)
+	var entries []pubsub.JournalEntry
+	for _, filename := range flagSet.Args() {
+		f, err := os.Open(filename)
+		if err != nil {
+			log.Errorf("runReplay: %v", err)
+			return 1
+		}
+		fileEntries, err := pubsub.ReadJournal(f)
+		f.Close()
+		if err != nil {
+			log.Errorf("runReplay %s: %v", filename, err)
+			return 1
+		}
+		entries = append(entries, fileEntries...)
+	}
+	// Merge the journals of the different agents
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Time.Before(entries[j].Time)
+	})
+	ps := pubsub.New(&pubsub.JournalDriver{Log: log, Entries: entries},
+		logger, log)
+	if *outputPtr != "" {
+		journal, err := pubsub.NewJournal(log, *outputPtr, 0)
+		if err != nil {
+			log.Errorf("runReplay: %v", err)
+			return 1
+		}
+		defer journal.Close()
+		ps.SetJournal(journal)
+	}
+	log.Noticef("Replaying %d entries into %s", len(entries), serviceName)
+	// The agent parses its own flags
+	os.Args = []string{serviceName}
+	return sep.f(ps, logger, log)
+}
+
 // startAgentAndDone starts the given agent. Writes the return/exit value to
 // <agentName>.done file should the agent return.
 func startAgentAndDone(sep entrypoint, agentName string, srvPs *pubsub.PubSub,